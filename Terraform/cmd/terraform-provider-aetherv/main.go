@@ -0,0 +1,53 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+// Run "go generate" to format example terraform files and generate the docs
+// for the registry/website.
+//
+//go:generate terraform fmt -recursive ./examples/
+//go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/aetherv/aether-v/terraform/internal/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+)
+
+// version is set via ldflags at release build time; it defaults to "dev"
+// for local builds.
+var version string = "dev"
+
+func main() {
+	var debug bool
+
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	muxServer, err := provider.NewMuxedServer(ctx, version)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// GetProviderSchemaOptional lets Terraform core skip the
+	// GetProviderSchema round trip on repeat plugin handshakes within the
+	// same run, which tf6muxserver.NewMuxServer's merged schema supports.
+	serveOpts := []tf6server.ServeOpt{tf6server.WithGetProviderSchemaOptional()}
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	err = tf6server.Serve(
+		"registry.terraform.io/charlespick/aetherv",
+		muxServer,
+		serveOpts...,
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
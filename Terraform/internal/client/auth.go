@@ -0,0 +1,436 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// AuthMode selects how the client obtains an Azure AD access token. The
+// provider surfaces this as the auth_mode attribute so callers aren't
+// forced into managing a long-lived client secret end to end (CI systems,
+// AKS workloads, and developer laptops all have a better-fitting mode).
+type AuthMode string
+
+const (
+	// AuthModeClientSecret is the default: OAuth2 client-credentials with a
+	// shared secret.
+	AuthModeClientSecret AuthMode = "client_secret"
+
+	// AuthModeClientCertificate exchanges a signed JWT client assertion
+	// built from a certificate/private key for a token.
+	AuthModeClientCertificate AuthMode = "client_certificate"
+
+	// AuthModeWorkloadIdentity exchanges the federated token written by
+	// AKS workload identity (AZURE_FEDERATED_TOKEN_FILE) for a token.
+	AuthModeWorkloadIdentity AuthMode = "workload_identity"
+
+	// AuthModeManagedIdentity fetches a token from IMDS.
+	AuthModeManagedIdentity AuthMode = "managed_identity"
+
+	// AuthModeAzureCLI shells out to `az account get-access-token`, useful
+	// for interactive developer use.
+	AuthModeAzureCLI AuthMode = "azure_cli"
+
+	// AuthModeOIDC exchanges a CI-provided OIDC token (supplied directly,
+	// or fetched from oidc_request_url with oidc_request_token, as GitHub
+	// Actions and GitLab CI do) for a token.
+	AuthModeOIDC AuthMode = "oidc"
+)
+
+// defaultAuthorityHost is the Azure AD authority for the Azure public
+// cloud. AuthConfig.AuthorityHost overrides this for sovereign clouds, e.g.
+// "https://login.microsoftonline.us" (AzureUSGovernment) or
+// "https://login.partner.microsoftonline.cn" (AzureChina).
+const defaultAuthorityHost = "https://login.microsoftonline.com"
+
+// AuthConfig describes how Client should authenticate to Azure AD. Only
+// the fields relevant to Mode need to be populated.
+type AuthConfig struct {
+	Mode AuthMode
+
+	TenantID string
+	ClientID string
+
+	// AuthorityHost overrides the Azure AD authority host for sovereign
+	// clouds. Defaults to the public cloud authority.
+	AuthorityHost string
+
+	// ClientSecret is used when Mode is AuthModeClientSecret.
+	ClientSecret string
+
+	// ClientCertificatePath is used when Mode is AuthModeClientCertificate.
+	// The file must be a PEM file containing both the certificate and its
+	// unencrypted private key. ClientCertificatePassword is accepted but not
+	// read: encrypted private keys are not supported.
+	ClientCertificatePath     string
+	ClientCertificatePassword string
+
+	// OIDCToken is used directly as the federated assertion when Mode is
+	// AuthModeOIDC and is non-empty. Otherwise OIDCRequestURL and
+	// OIDCRequestToken are used to fetch one, mirroring
+	// ACTIONS_ID_TOKEN_REQUEST_URL / ACTIONS_ID_TOKEN_REQUEST_TOKEN on
+	// GitHub Actions and CI_JOB_JWT-style variables on GitLab CI.
+	OIDCToken        string
+	OIDCRequestURL   string
+	OIDCRequestToken string
+}
+
+func (a AuthConfig) authorityHost() string {
+	if a.AuthorityHost != "" {
+		return strings.TrimRight(a.AuthorityHost, "/")
+	}
+	return defaultAuthorityHost
+}
+
+func (a AuthConfig) tokenURL() string {
+	return fmt.Sprintf("%s/%s/oauth2/v2.0/token", a.authorityHost(), a.TenantID)
+}
+
+func (a AuthConfig) scope() string {
+	return fmt.Sprintf("api://%s/.default", a.ClientID)
+}
+
+// tokenSource builds the oauth2.TokenSource for a.Mode.
+func (a AuthConfig) tokenSource(ctx context.Context) (oauth2.TokenSource, error) {
+	switch a.Mode {
+	case "", AuthModeClientSecret:
+		cc := &clientcredentials.Config{
+			ClientID:     a.ClientID,
+			ClientSecret: a.ClientSecret,
+			TokenURL:     a.tokenURL(),
+			Scopes:       []string{a.scope()},
+		}
+		return cc.TokenSource(ctx), nil
+
+	case AuthModeClientCertificate:
+		return newAssertionTokenSource(a.tokenURL(), a.ClientID, a.scope(), a.certificateAssertion), nil
+
+	case AuthModeWorkloadIdentity:
+		return newAssertionTokenSource(a.tokenURL(), a.ClientID, a.scope(), a.workloadIdentityAssertion), nil
+
+	case AuthModeOIDC:
+		return newAssertionTokenSource(a.tokenURL(), a.ClientID, a.scope(), a.oidcAssertion), nil
+
+	case AuthModeManagedIdentity:
+		return newManagedIdentityTokenSource(a.ClientID, a.scope()), nil
+
+	case AuthModeAzureCLI:
+		return newAzureCLITokenSource(a.scope()), nil
+
+	default:
+		return nil, fmt.Errorf("unknown auth_mode %q", a.Mode)
+	}
+}
+
+// certificateAssertion builds a self-signed JWT client assertion from
+// ClientCertificatePath, per the Azure AD client-credentials-with-certificate
+// flow (RFC 7523).
+func (a AuthConfig) certificateAssertion(ctx context.Context) (string, error) {
+	certPEM, err := os.ReadFile(a.ClientCertificatePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read client certificate %s: %w", a.ClientCertificatePath, err)
+	}
+
+	keyPair, err := tls.X509KeyPair(certPEM, certPEM)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse client certificate %s: %w", a.ClientCertificatePath, err)
+	}
+
+	leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return "", fmt.Errorf("failed to parse client certificate leaf: %w", err)
+	}
+
+	signer, ok := keyPair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return "", fmt.Errorf("client certificate private key does not support signing")
+	}
+	rsaKey, ok := signer.(*rsa.PrivateKey)
+	if !ok {
+		return "", fmt.Errorf("client certificate private key must be RSA")
+	}
+
+	thumbprint := sha256.Sum256(leaf.Raw)
+
+	header := map[string]interface{}{
+		"alg":      "RS256",
+		"typ":      "JWT",
+		"x5t#S256": base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	}
+	now := time.Now()
+	claims := map[string]interface{}{
+		"aud": a.tokenURL(),
+		"iss": a.ClientID,
+		"sub": a.ClientID,
+		"jti": fmt.Sprintf("%x", thumbprint[:8]),
+		"nbf": now.Unix(),
+		"exp": now.Add(10 * time.Minute).Unix(),
+	}
+
+	return signJWT(header, claims, rsaKey)
+}
+
+// workloadIdentityAssertion reads the federated token AKS workload identity
+// (or any other federated-identity-credential integration) writes to
+// AZURE_FEDERATED_TOKEN_FILE. That token is itself a valid JWT bearer
+// assertion and is passed to Azure AD unmodified.
+func (a AuthConfig) workloadIdentityAssertion(ctx context.Context) (string, error) {
+	path := os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+	if path == "" {
+		return "", fmt.Errorf("AZURE_FEDERATED_TOKEN_FILE is not set; auth_mode = \"workload_identity\" requires it (see the Azure Workload Identity webhook)")
+	}
+
+	token, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read federated token file %s: %w", path, err)
+	}
+
+	return strings.TrimSpace(string(token)), nil
+}
+
+// oidcAssertion returns OIDCToken if set, otherwise fetches one from
+// OIDCRequestURL using OIDCRequestToken as a bearer credential, matching
+// GitHub Actions' ACTIONS_ID_TOKEN_REQUEST_URL / ACTIONS_ID_TOKEN_REQUEST_TOKEN
+// and the equivalent GitLab CI variables.
+func (a AuthConfig) oidcAssertion(ctx context.Context) (string, error) {
+	if a.OIDCToken != "" {
+		return a.OIDCToken, nil
+	}
+
+	if a.OIDCRequestURL == "" || a.OIDCRequestToken == "" {
+		return "", fmt.Errorf("auth_mode = \"oidc\" requires either oidc_token, or both oidc_request_url and oidc_request_token")
+	}
+
+	reqURL := a.OIDCRequestURL
+	if !strings.Contains(reqURL, "audience=") {
+		sep := "?"
+		if strings.Contains(reqURL, "?") {
+			sep = "&"
+		}
+		reqURL = fmt.Sprintf("%s%saudience=api://AzureADTokenExchange", reqURL, sep)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create OIDC token request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+a.OIDCRequestToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch OIDC token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to parse OIDC token response: %w", err)
+	}
+	if body.Value == "" {
+		return "", fmt.Errorf("OIDC token endpoint %s returned an empty token", a.OIDCRequestURL)
+	}
+
+	return body.Value, nil
+}
+
+// signJWT base64url-encodes header and claims and signs them with RS256.
+func signJWT(header, claims map[string]interface{}, key *rsa.PrivateKey) (string, error) {
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT header: %w", err)
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT claims: %w", err)
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign JWT: %w", err)
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// assertionTokenSource exchanges a freshly minted client assertion (from
+// assertionFunc) for an access token on every refresh, since assertions are
+// short-lived (workload identity/OIDC tokens expire in minutes, and the
+// certificate assertion above is only valid for 10 minutes).
+type assertionTokenSource struct {
+	tokenURL      string
+	clientID      string
+	scope         string
+	assertionFunc func(context.Context) (string, error)
+}
+
+func newAssertionTokenSource(tokenURL, clientID, scope string, assertionFunc func(context.Context) (string, error)) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, &assertionTokenSource{
+		tokenURL:      tokenURL,
+		clientID:      clientID,
+		scope:         scope,
+		assertionFunc: assertionFunc,
+	})
+}
+
+func (s *assertionTokenSource) Token() (*oauth2.Token, error) {
+	ctx := context.Background()
+
+	assertion, err := s.assertionFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"client_id":             {s.clientID},
+		"scope":                 {s.scope},
+		"grant_type":            {"client_credentials"},
+		"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"client_assertion":      {assertion},
+	}
+
+	resp, err := http.PostForm(s.tokenURL, form)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange client assertion for a token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeTokenResponse(resp)
+}
+
+// managedIdentityTokenSource fetches a token from the Azure Instance
+// Metadata Service, used when the provider runs on an Azure VM, VMSS, AKS
+// node, or other resource with a managed identity attached.
+type managedIdentityTokenSource struct {
+	clientID string
+	resource string
+}
+
+func newManagedIdentityTokenSource(clientID, scope string) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, &managedIdentityTokenSource{
+		clientID: clientID,
+		resource: strings.TrimSuffix(scope, "/.default"),
+	})
+}
+
+func (s *managedIdentityTokenSource) Token() (*oauth2.Token, error) {
+	imdsURL := fmt.Sprintf(
+		"http://169.254.169.254/metadata/identity/oauth2/token?api-version=2018-02-01&resource=%s",
+		url.QueryEscape(s.resource),
+	)
+	if s.clientID != "" {
+		imdsURL += "&client_id=" + url.QueryEscape(s.clientID)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, imdsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IMDS request: %w", err)
+	}
+	req.Header.Set("Metadata", "true")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach IMDS (is auth_mode = \"managed_identity\" correct for this environment?): %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeTokenResponse(resp)
+}
+
+// azureCLITokenSource shells out to `az account get-access-token`, mirroring
+// how the AzureRM provider supports developer-laptop auth without any
+// credentials in configuration.
+type azureCLITokenSource struct {
+	resource string
+}
+
+func newAzureCLITokenSource(scope string) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, &azureCLITokenSource{
+		resource: strings.TrimSuffix(scope, "/.default"),
+	})
+}
+
+func (s *azureCLITokenSource) Token() (*oauth2.Token, error) {
+	cmd := exec.Command("az", "account", "get-access-token", "--resource", s.resource, "--output", "json")
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run `az account get-access-token` (auth_mode = \"azure_cli\" requires the Azure CLI to be installed and logged in): %w", err)
+	}
+
+	var parsed struct {
+		AccessToken string `json:"accessToken"`
+		ExpiresOn   string `json:"expiresOn"`
+	}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse `az account get-access-token` output: %w", err)
+	}
+
+	expiry := time.Now().Add(5 * time.Minute)
+	if parsed.ExpiresOn != "" {
+		if t, err := time.ParseInLocation("2006-01-02 15:04:05.999999", parsed.ExpiresOn, time.Local); err == nil {
+			expiry = t
+		}
+	}
+
+	return &oauth2.Token{
+		AccessToken: parsed.AccessToken,
+		TokenType:   "Bearer",
+		Expiry:      expiry,
+	}, nil
+}
+
+// decodeTokenResponse parses the common {access_token, expires_in} shape
+// shared by the Azure AD token endpoint and IMDS.
+func decodeTokenResponse(resp *http.Response) (*oauth2.Token, error) {
+	var body struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   string `json:"expires_in"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to parse token response: %w", err)
+	}
+	if resp.StatusCode >= 400 || body.AccessToken == "" {
+		return nil, fmt.Errorf("token endpoint returned status %d", resp.StatusCode)
+	}
+
+	token := &oauth2.Token{
+		AccessToken: body.AccessToken,
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(60 * time.Minute),
+	}
+	if body.ExpiresIn != "" {
+		if secs, err := strconv.Atoi(body.ExpiresIn); err == nil {
+			token.Expiry = time.Now().Add(time.Duration(secs) * time.Second)
+		}
+	}
+
+	return token, nil
+}
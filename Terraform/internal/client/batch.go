@@ -0,0 +1,221 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BatchOptions configures BatchClient's request coalescing.
+type BatchOptions struct {
+	// MaxBatchSize flushes a pending group as soon as it reaches this many
+	// requests. Zero uses DefaultMaxBatchSize.
+	MaxBatchSize int
+
+	// MaxDelay flushes a pending group this long after its first request
+	// arrived, even if MaxBatchSize hasn't been reached. Zero uses
+	// DefaultMaxDelay.
+	MaxDelay time.Duration
+}
+
+const (
+	// DefaultMaxBatchSize is BatchOptions.MaxBatchSize's default.
+	DefaultMaxBatchSize = 25
+
+	// DefaultMaxDelay is BatchOptions.MaxDelay's default.
+	DefaultMaxDelay = 100 * time.Millisecond
+)
+
+// BatchResult is one VM's outcome within a submitted batch.
+type BatchResult struct {
+	CorrelationID string `json:"correlation_id"`
+	VMID          string `json:"vm_id,omitempty"`
+	JobID         string `json:"job_id,omitempty"`
+	TargetHost    string `json:"target_host,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// batchVMRequest pairs a VmSpec with the correlation ID BatchClient uses to
+// demultiplex the server's response back to the waiting caller.
+type batchVMRequest struct {
+	CorrelationID string  `json:"correlation_id"`
+	Spec          *VmSpec `json:"spec"`
+}
+
+// newCorrelationID generates a random hex identifier used to demultiplex a
+// batch response back to the Submit call that's waiting on it.
+func newCorrelationID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// pendingGroup accumulates requests for one host/cluster key until it's
+// flushed, either because it hit MaxBatchSize or MaxDelay elapsed.
+type pendingGroup struct {
+	requests []batchVMRequest
+	waiters  map[string]chan BatchResult
+	timer    *time.Timer
+}
+
+// BatchClient coalesces CreateVM-shaped requests that land within a short
+// window into a single POST to PathVMsBatch, so a `count = 50` resource
+// doesn't turn into 50 independent jobs (and 50 independent token
+// refreshes) against the server. Requests are grouped by host/cluster,
+// since that's the unit the server-side job scheduler operates on.
+//
+// Create a BatchClient once per provider instance (see the provider's
+// "batching" block) and share it across all Create calls; it is safe for
+// concurrent use.
+type BatchClient struct {
+	client *Client
+	opts   BatchOptions
+
+	mu     sync.Mutex
+	groups map[string]*pendingGroup
+}
+
+// NewBatchClient creates a BatchClient backed by c. A zero-value opts uses
+// DefaultMaxBatchSize and DefaultMaxDelay.
+func NewBatchClient(c *Client, opts BatchOptions) *BatchClient {
+	if opts.MaxBatchSize <= 0 {
+		opts.MaxBatchSize = DefaultMaxBatchSize
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = DefaultMaxDelay
+	}
+
+	return &BatchClient{
+		client: c,
+		opts:   opts,
+		groups: make(map[string]*pendingGroup),
+	}
+}
+
+// groupKey returns the coalescing key for a VmSpec: requests only batch
+// together if they target the same host or the same cluster.
+func groupKey(spec *VmSpec) string {
+	if spec.Cluster != "" {
+		return "cluster:" + spec.Cluster
+	}
+	return "host:" + spec.Host
+}
+
+// Submit enqueues spec for the next batch targeting its host/cluster and
+// returns a channel that receives this VM's BatchResult once the group is
+// flushed and the server responds. The channel is closed after sending
+// exactly one result; if the batch submission fails (including because ctx
+// was cancelled), that result carries the error in its Error field.
+func (b *BatchClient) Submit(ctx context.Context, spec *VmSpec) (<-chan BatchResult, error) {
+	correlationID, err := newCorrelationID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate correlation ID: %w", err)
+	}
+
+	result := make(chan BatchResult, 1)
+	key := groupKey(spec)
+
+	b.mu.Lock()
+	group, ok := b.groups[key]
+	if !ok {
+		group = &pendingGroup{waiters: make(map[string]chan BatchResult)}
+		b.groups[key] = group
+		group.timer = time.AfterFunc(b.opts.MaxDelay, func() { b.flush(ctx, key) })
+	}
+
+	group.requests = append(group.requests, batchVMRequest{CorrelationID: correlationID, Spec: spec})
+	group.waiters[correlationID] = result
+
+	flushNow := len(group.requests) >= b.opts.MaxBatchSize
+	if flushNow {
+		group.timer.Stop()
+	}
+	b.mu.Unlock()
+
+	if flushNow {
+		b.flush(ctx, key)
+	}
+
+	return result, nil
+}
+
+// flush submits whatever is pending for key, if anything still is (another
+// caller may have already flushed it), and demultiplexes the response back
+// to each waiter.
+func (b *BatchClient) flush(ctx context.Context, key string) {
+	b.mu.Lock()
+	group, ok := b.groups[key]
+	if !ok || len(group.requests) == 0 {
+		b.mu.Unlock()
+		return
+	}
+	delete(b.groups, key)
+	b.mu.Unlock()
+
+	results, err := b.submitBatch(ctx, group.requests)
+	if err != nil {
+		for _, waiter := range group.waiters {
+			waiter <- BatchResult{Error: err.Error()}
+			close(waiter)
+		}
+		return
+	}
+
+	byCorrelation := make(map[string]BatchResult, len(results))
+	for _, r := range results {
+		byCorrelation[r.CorrelationID] = r
+	}
+
+	for correlationID, waiter := range group.waiters {
+		r, ok := byCorrelation[correlationID]
+		if !ok {
+			r = BatchResult{CorrelationID: correlationID, Error: "server response did not include this request"}
+		}
+		waiter <- r
+		close(waiter)
+	}
+}
+
+// submitBatch POSTs the accumulated requests to PathVMsBatch as one job.
+func (b *BatchClient) submitBatch(ctx context.Context, requests []batchVMRequest) ([]BatchResult, error) {
+	body, err := json.Marshal(struct {
+		Requests []batchVMRequest `json:"requests"`
+	}{Requests: requests})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal batch request: %w", err)
+	}
+
+	respBody, err := b.client.Post(ctx, PathVMsBatch, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("batch VM submission failed: %w", err)
+	}
+
+	var batchResp struct {
+		JobID   string        `json:"job_id"`
+		Results []BatchResult `json:"results"`
+	}
+	if err := json.Unmarshal(respBody, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to parse batch response: %w", err)
+	}
+
+	// The batch itself is one job; wait for it so Results (filled in by the
+	// server as it provisions, and deduplicates ISO/VHDX copies across
+	// sibling VMs) reflects the final per-VM outcome.
+	if batchResp.JobID != "" {
+		if _, err := b.client.WaitForJob(ctx, batchResp.JobID); err != nil {
+			return nil, fmt.Errorf("batch job %s failed: %w", batchResp.JobID, err)
+		}
+	}
+
+	return batchResp.Results, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Checkpoint represents a Hyper-V checkpoint (what vSphere/KVM call a
+// snapshot) from the API.
+type Checkpoint struct {
+	ID                 string `json:"id,omitempty"`
+	VMID               string `json:"vm_id"`
+	Name               string `json:"name,omitempty"`
+	CheckpointType     string `json:"checkpoint_type,omitempty"`
+	ParentCheckpointID string `json:"parent_checkpoint_id,omitempty"`
+	Quiesce            bool   `json:"quiesce,omitempty"`
+	IncludeMemory      bool   `json:"include_memory,omitempty"`
+	CreatedAt          string `json:"created_at,omitempty"`
+}
+
+// CheckpointSpec represents the request body for creating a checkpoint.
+type CheckpointSpec struct {
+	VMID           string `json:"vm_id"`
+	Name           string `json:"name,omitempty"`
+	CheckpointType string `json:"checkpoint_type,omitempty"`
+
+	// Quiesce requests VSS (Windows) or fsfreeze (Linux) guest-side
+	// coordination before the checkpoint is taken, for a consistent disk
+	// state. Requires integration services' backup (VSS) service.
+	Quiesce bool `json:"quiesce,omitempty"`
+
+	// IncludeMemory captures the VM's in-memory state alongside its disks,
+	// so ApplyCheckpoint restores a running VM to exactly where it left
+	// off instead of a crash-consistent boot.
+	IncludeMemory bool `json:"include_memory,omitempty"`
+}
+
+// ListCheckpoints returns all checkpoints for a virtual machine.
+func (c *Client) ListCheckpoints(ctx context.Context, vmID string) ([]Checkpoint, error) {
+	path := fmt.Sprintf(PathVMCheckpoints, url.PathEscape(vmID))
+	respBody, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoints []Checkpoint
+	if err := json.Unmarshal(respBody, &checkpoints); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoints response: %w", err)
+	}
+
+	return checkpoints, nil
+}
+
+// GetCheckpoint returns a specific checkpoint by ID.
+func (c *Client) GetCheckpoint(ctx context.Context, checkpointID string) (*Checkpoint, error) {
+	path := fmt.Sprintf(PathCheckpointByID, url.PathEscape(checkpointID))
+	respBody, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(respBody, &checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint response: %w", err)
+	}
+
+	return &checkpoint, nil
+}
+
+// CreateCheckpoint creates a new checkpoint of a virtual machine's current
+// state. This is the "create" step of the create/apply/delete sequence
+// Packer's Azure ARM builder uses for its own snapshot handling: each verb
+// is a separate API call against the checkpoint, not a single combined
+// snapshot-and-restore operation.
+func (c *Client) CreateCheckpoint(ctx context.Context, spec *CheckpointSpec) (*JobResult, error) {
+	path := fmt.Sprintf(PathVMCheckpoints, url.PathEscape(spec.VMID))
+
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal checkpoint spec: %w", err)
+	}
+
+	respBody, err := c.Post(ctx, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ApplyCheckpoint reverts the virtual machine to the state captured by
+// checkpointID (the "apply" step).
+func (c *Client) ApplyCheckpoint(ctx context.Context, checkpointID string) (*JobResult, error) {
+	path := fmt.Sprintf(PathCheckpointApply, url.PathEscape(checkpointID))
+
+	respBody, err := c.Post(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ConsolidateDisks merges a VM's checkpoint differencing disks back into
+// its base disk, reclaiming the space held by deleted checkpoints whose
+// disk chains hadn't yet been cleaned up.
+func (c *Client) ConsolidateDisks(ctx context.Context, vmID string) (*JobResult, error) {
+	path := fmt.Sprintf(PathVMConsolidateDisks, url.PathEscape(vmID))
+
+	respBody, err := c.Post(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteCheckpoint deletes a checkpoint (the "delete" step).
+func (c *Client) DeleteCheckpoint(ctx context.Context, checkpointID string) (*JobResult, error) {
+	path := fmt.Sprintf(PathCheckpointByID, url.PathEscape(checkpointID))
+	respBody, err := c.Delete(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
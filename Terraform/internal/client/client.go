@@ -5,31 +5,27 @@ package client
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
 	"strings"
 	"time"
 
 	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/clientcredentials"
 )
 
 const (
 	// Default timeout for API requests
 	DefaultTimeout = 30 * time.Second
-
-	// Job polling configuration
-	JobPollInterval    = 2 * time.Second
-	JobPollMaxAttempts = 150 // 5 minutes max wait
 )
 
 // Client is the Aether-V API client.
 type Client struct {
 	serverURL  string
 	httpClient *http.Client
+
+	// versionState caches the result of RemoteAPIVersion; see version.go.
+	versionState
 }
 
 // JobStatus represents the status of an async job.
@@ -42,11 +38,16 @@ const (
 	JobStatusFailed    JobStatus = "failed"
 )
 
-// JobResult represents the response from creating an async operation.
+// JobResult represents the response from creating an async operation. For
+// operations that allocate a resource up front (e.g. CreateVM), VMID and
+// TargetHost are populated immediately so callers can checkpoint state
+// before the job finishes; see resources.go's CreateVM.
 type JobResult struct {
-	JobID   string `json:"job_id"`
-	Status  string `json:"status"`
-	Message string `json:"message"`
+	JobID      string `json:"job_id"`
+	Status     string `json:"status"`
+	Message    string `json:"message"`
+	VMID       string `json:"vm_id,omitempty"`
+	TargetHost string `json:"target_host,omitempty"`
 }
 
 // Job represents a job from the job queue.
@@ -61,24 +62,19 @@ type Job struct {
 	Result      any        `json:"result,omitempty"`
 }
 
-// NewClient creates a new Aether-V API client with OAuth2 authentication.
-func NewClient(ctx context.Context, serverURL, clientID, clientSecret, tenantID string) (*Client, error) {
+// NewClient creates a new Aether-V API client, authenticating to Azure AD
+// according to auth.Mode (see AuthMode for the supported credential chain).
+func NewClient(ctx context.Context, serverURL string, auth AuthConfig) (*Client, error) {
 	// Ensure server URL doesn't have trailing slash
 	serverURL = strings.TrimRight(serverURL, "/")
 
-	// Build the token endpoint URL using Microsoft identity platform v2.0
-	tokenURL := fmt.Sprintf("https://login.microsoftonline.com/%s/oauth2/v2.0/token", tenantID)
-
-	// Configure the OAuth2 client credentials
-	config := &clientcredentials.Config{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		TokenURL:     tokenURL,
-		Scopes:       []string{fmt.Sprintf("api://%s/.default", clientID)},
+	tokenSource, err := auth.tokenSource(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure auth_mode %q: %w", auth.Mode, err)
 	}
 
 	// Create an HTTP client that automatically handles token refresh
-	httpClient := config.Client(ctx)
+	httpClient := oauth2.NewClient(ctx, tokenSource)
 	httpClient.Timeout = DefaultTimeout
 
 	return &Client{
@@ -134,51 +130,16 @@ func (c *Client) Put(ctx context.Context, path string, body io.Reader) ([]byte,
 	return c.doRequest(ctx, http.MethodPut, path, body)
 }
 
+// Patch performs a PATCH request.
+func (c *Client) Patch(ctx context.Context, path string, body io.Reader) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPatch, path, body)
+}
+
 // Delete performs a DELETE request.
 func (c *Client) Delete(ctx context.Context, path string) ([]byte, error) {
 	return c.doRequest(ctx, http.MethodDelete, path, nil)
 }
 
-// WaitForJob polls a job until it completes or fails.
-func (c *Client) WaitForJob(ctx context.Context, jobID string) (*Job, error) {
-	path := fmt.Sprintf("/api/v1/jobs/%s", url.PathEscape(jobID))
-
-	for attempt := 0; attempt < JobPollMaxAttempts; attempt++ {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(JobPollInterval):
-		}
-
-		respBody, err := c.Get(ctx, path)
-		if err != nil {
-			return nil, fmt.Errorf("failed to poll job status: %w", err)
-		}
-
-		var job Job
-		if err := json.Unmarshal(respBody, &job); err != nil {
-			return nil, fmt.Errorf("failed to parse job response: %w", err)
-		}
-
-		switch job.Status {
-		case JobStatusCompleted:
-			return &job, nil
-		case JobStatusFailed:
-			errMsg := "job failed"
-			if job.Error != nil {
-				errMsg = *job.Error
-			}
-			return &job, fmt.Errorf("job %s failed: %s", jobID, errMsg)
-		case JobStatusPending, JobStatusRunning:
-			// Continue polling
-		default:
-			return nil, fmt.Errorf("unknown job status: %s", job.Status)
-		}
-	}
-
-	return nil, fmt.Errorf("job %s did not complete within the expected time", jobID)
-}
-
 // HealthCheck verifies the API server is reachable and healthy.
 func (c *Client) HealthCheck(ctx context.Context) error {
 	_, err := c.Get(ctx, "/healthz")
@@ -0,0 +1,107 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// CloudInitISO represents a cloud-init/Ignition ISO the server rendered and
+// attached to a VM as a CD/DVD drive.
+type CloudInitISO struct {
+	ID   string `json:"id,omitempty"`
+	VMID string `json:"vm_id"`
+	Path string `json:"path,omitempty"`
+}
+
+// CloudInitSpec represents the request body for rendering and attaching a
+// cloud-init/Ignition ISO.
+type CloudInitSpec struct {
+	VMID          string `json:"vm_id"`
+	UserData      string `json:"user_data,omitempty"`
+	MetaData      string `json:"meta_data,omitempty"`
+	NetworkConfig string `json:"network_config,omitempty"`
+	Datasource    string `json:"datasource,omitempty"`
+}
+
+// CreateCloudInitISO renders spec's user_data/meta_data/network_config into
+// an ISO (Hyper-V has no native cloud-init datasource) and attaches it to
+// the VM as a CD/DVD drive.
+func (c *Client) CreateCloudInitISO(ctx context.Context, spec *CloudInitSpec) (*JobResult, error) {
+	path := fmt.Sprintf(PathVMCloudInit, url.PathEscape(spec.VMID))
+
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cloud-init spec: %w", err)
+	}
+
+	respBody, err := c.Post(ctx, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// IgnitionSpec represents the request body for rendering and attaching an
+// Ignition config (CoreOS/Flatcar), the Ignition counterpart to
+// CloudInitSpec for distros that don't use cloud-init.
+type IgnitionSpec struct {
+	VMID   string `json:"vm_id"`
+	Config string `json:"config"`
+}
+
+// CreateIgnitionISO renders spec.Config into an ISO and attaches it to the
+// VM as a CD/DVD drive, the same way CreateCloudInitISO does for
+// NoCloud/ConfigDrive payloads.
+func (c *Client) CreateIgnitionISO(ctx context.Context, spec *IgnitionSpec) (*JobResult, error) {
+	path := fmt.Sprintf(PathVMCloudInit, url.PathEscape(spec.VMID))
+
+	body, err := json.Marshal(struct {
+		VMID       string `json:"vm_id"`
+		Datasource string `json:"datasource"`
+		Ignition   string `json:"ignition_config"`
+	}{VMID: spec.VMID, Datasource: "ignition", Ignition: spec.Config})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ignition spec: %w", err)
+	}
+
+	respBody, err := c.Post(ctx, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetCloudInitISO returns a rendered cloud-init ISO's metadata, including
+// the path it was materialized to.
+func (c *Client) GetCloudInitISO(ctx context.Context, isoID string) (*CloudInitISO, error) {
+	path := fmt.Sprintf(PathCloudInitByID, url.PathEscape(isoID))
+	respBody, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var iso CloudInitISO
+	if err := json.Unmarshal(respBody, &iso); err != nil {
+		return nil, fmt.Errorf("failed to parse cloud-init ISO response: %w", err)
+	}
+
+	return &iso, nil
+}
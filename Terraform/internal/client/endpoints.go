@@ -7,27 +7,60 @@ package client
 // API endpoint paths
 const (
 	// Virtual Machines
-	PathVMs           = "/api/v1/resources/vms"
-	PathVMByID        = "/api/v1/resources/vms/%s"
-	PathVMInitialize  = "/api/v1/resources/vms/%s/initialize"
+	PathVMs                   = "/api/v1/resources/vms"
+	PathVMByID                = "/api/v1/resources/vms/%s"
+	PathVMInitialize          = "/api/v1/resources/vms/%s/initialize"
+	PathVMPower               = "/api/v1/resources/vms/%s/power/%s"
+	PathVMClone               = "/api/v1/resources/vms/%s/clone"
+	PathVMConvertToTemplate   = "/api/v1/resources/vms/%s/convert-to-template"
+	PathVMConvertFromTemplate = "/api/v1/resources/vms/%s/convert-from-template"
 
 	// Disks
-	PathDisks         = "/api/v1/resources/disks"
-	PathDiskByID      = "/api/v1/resources/disks/%s"
-	PathVMDisks       = "/api/v1/resources/vms/%s/disks"
+	PathDisks    = "/api/v1/resources/disks"
+	PathDiskByID = "/api/v1/resources/disks/%s"
+	PathVMDisks  = "/api/v1/resources/vms/%s/disks"
 
 	// Network Adapters
-	PathNICs          = "/api/v1/resources/nics"
-	PathNICByID       = "/api/v1/resources/nics/%s"
-	PathVMNICs        = "/api/v1/resources/vms/%s/nics"
+	PathNICs    = "/api/v1/resources/nics"
+	PathNICByID = "/api/v1/resources/nics/%s"
+	PathVMNICs  = "/api/v1/resources/vms/%s/nics"
+
+	// CD/DVD Drives
+	PathCDROMs    = "/api/v1/resources/cdroms"
+	PathCDROMByID = "/api/v1/resources/cdroms/%s"
+	PathVMCDROMs  = "/api/v1/resources/vms/%s/cdroms"
+
+	// Checkpoints
+	PathVMCheckpoints      = "/api/v1/resources/vms/%s/checkpoints"
+	PathCheckpointByID     = "/api/v1/resources/checkpoints/%s"
+	PathCheckpointApply    = "/api/v1/resources/checkpoints/%s/apply"
+	PathVMConsolidateDisks = "/api/v1/resources/vms/%s/checkpoints/consolidate"
+
+	// Cloud-Init
+	PathVMCloudInit   = "/api/v1/resources/vms/%s/cloud-init"
+	PathCloudInitByID = "/api/v1/resources/cloud-init/%s"
 
 	// Jobs
-	PathJobs          = "/api/v1/jobs"
-	PathJobByID       = "/api/v1/jobs/%s"
+	PathJobs    = "/api/v1/jobs"
+	PathJobByID = "/api/v1/jobs/%s"
+
+	// Batch submission
+	PathVMsBatch = "/api/v1/vms:batch"
+	PathBatch    = "/api/v1/batch"
 
 	// Inventory
-	PathInventory     = "/api/v1/inventory"
-	PathHosts         = "/api/v1/hosts"
-	PathImages        = "/api/v1/images"
-	PathNetworks      = "/api/v1/networks"
+	PathInventory = "/api/v1/inventory"
+	PathHosts     = "/api/v1/hosts"
+	PathImages    = "/api/v1/images"
+
+	// Networks (also used to list/create virtual switches; see PathNetworkByID
+	// for operations on a specific one)
+	PathNetworks    = "/api/v1/networks"
+	PathNetworkByID = "/api/v1/networks/%s"
+
+	// Tags (generic, cross-resource)
+	PathResourceTags = "/api/v1/resources/%s/%s/tags"
+
+	// Server metadata
+	PathVersion = "/api/v1/version"
 )
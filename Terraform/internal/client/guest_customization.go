@@ -0,0 +1,125 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import "fmt"
+
+// GuestCustomization describes the in-guest specialization applied by
+// InitializeVM. It replaces the previously untyped guest_configuration
+// payload with validated fields shared across guest families, plus a
+// WindowsOptions/LinuxOptions discriminator for settings that only make
+// sense on one of the two. The json tags match the shape the server has
+// always accepted under the "guest_configuration" key.
+type GuestCustomization struct {
+	OSFamily OSFamily `json:"os_family"`
+
+	Hostname      string `json:"hostname,omitempty"`
+	Timezone      string `json:"timezone,omitempty"`
+	AdminPassword string `json:"admin_password,omitempty"`
+
+	User       *GuestUser      `json:"user,omitempty"`
+	DomainJoin *DomainJoinSpec `json:"domain_join,omitempty"`
+	Ansible    *GuestAnsible   `json:"ansible,omitempty"`
+
+	DNSSuffixes []string `json:"dns_suffixes,omitempty"`
+	DNSServers  []string `json:"dns_servers,omitempty"`
+
+	NetworkInterfaces []GuestNetworkInterface `json:"network_interfaces,omitempty"`
+
+	SSHAuthorizedKeys []string `json:"ssh_authorized_keys,omitempty"`
+	FirstBootScript   string   `json:"first_boot_script,omitempty"`
+
+	WindowsOptions *WindowsGuestOptions `json:"windows_options,omitempty"`
+	LinuxOptions   *LinuxGuestOptions   `json:"linux_options,omitempty"`
+}
+
+// GuestUser sets the initial local account's credentials.
+type GuestUser struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// DomainJoinSpec joins the guest to an Active Directory domain during
+// specialization.
+type DomainJoinSpec struct {
+	DomainName         string `json:"domain_name"`
+	OrganizationalUnit string `json:"organizational_unit,omitempty"`
+	DomainUser         string `json:"username"`
+	DomainPassword     string `json:"password"`
+}
+
+// GuestAnsible has the server drop an SSH key for the given user so a
+// control node can reach the guest over Ansible as soon as it's up.
+type GuestAnsible struct {
+	Username string `json:"username"`
+	SSHKey   string `json:"ssh_key"`
+}
+
+// GuestNetworkInterface configures one of the VM's network adapters during
+// specialization, matched to a NIC by MACAddress. Exactly one of DHCP or a
+// static IPv4/IPv6 address must be set.
+type GuestNetworkInterface struct {
+	MACAddress string `json:"mac_address"`
+	DHCP       bool   `json:"dhcp,omitempty"`
+
+	IPv4Address string `json:"ipv4_address,omitempty"`
+	IPv4Prefix  int    `json:"ipv4_prefix,omitempty"`
+	IPv4Gateway string `json:"ipv4_gateway,omitempty"`
+
+	IPv6Address string `json:"ipv6_address,omitempty"`
+	IPv6Prefix  int    `json:"ipv6_prefix,omitempty"`
+	IPv6Gateway string `json:"ipv6_gateway,omitempty"`
+}
+
+// WindowsGuestOptions holds settings that only apply when OSFamily is
+// OSFamilyWindows.
+type WindowsGuestOptions struct {
+	ProductKey         string   `json:"product_key,omitempty"`
+	ComputerName       string   `json:"computer_name,omitempty"`
+	Locale             string   `json:"locale,omitempty"`
+	AutoLogonCount     int      `json:"auto_logon_count,omitempty"`
+	FirstLogonCommands []string `json:"first_logon_commands,omitempty"`
+}
+
+// LinuxGuestOptions holds settings that only apply when OSFamily is
+// OSFamilyLinux.
+type LinuxGuestOptions struct {
+	Locale string   `json:"locale,omitempty"`
+	RunCmd []string `json:"run_cmd,omitempty"`
+}
+
+// Validate checks gc for shape errors the server would otherwise reject as
+// a failed job, so InitializeVM can fail fast before the round-trip.
+func (gc *GuestCustomization) Validate() error {
+	if gc.OSFamily != OSFamilyWindows && gc.OSFamily != OSFamilyLinux {
+		return fmt.Errorf("os_family must be %q or %q, got %q", OSFamilyWindows, OSFamilyLinux, gc.OSFamily)
+	}
+	if gc.WindowsOptions != nil && gc.OSFamily != OSFamilyWindows {
+		return fmt.Errorf("windows_options requires os_family %q", OSFamilyWindows)
+	}
+	if gc.LinuxOptions != nil && gc.OSFamily != OSFamilyLinux {
+		return fmt.Errorf("linux_options requires os_family %q", OSFamilyLinux)
+	}
+
+	if gc.DomainJoin != nil {
+		if gc.DomainJoin.DomainName == "" {
+			return fmt.Errorf("domain_join.domain_name is required")
+		}
+		if gc.DomainJoin.DomainUser == "" || gc.DomainJoin.DomainPassword == "" {
+			return fmt.Errorf("domain_join requires both a username and password")
+		}
+	}
+
+	for i, nic := range gc.NetworkInterfaces {
+		hasStatic := nic.IPv4Address != "" || nic.IPv6Address != ""
+		switch {
+		case nic.DHCP && hasStatic:
+			return fmt.Errorf("network_interfaces[%d]: dhcp cannot be combined with a static address", i)
+		case !nic.DHCP && !hasStatic:
+			return fmt.Errorf("network_interfaces[%d]: must set dhcp or a static ipv4/ipv6 address", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Image represents an uploaded or golden image available as a VM's boot
+// disk source or a CD-ROM attach.
+type Image struct {
+	Name     string   `json:"name,omitempty"`
+	Path     string   `json:"path,omitempty"`
+	SizeGB   float64  `json:"size_gb,omitempty"`
+	OSFamily OSFamily `json:"os_family,omitempty"`
+	Host     string   `json:"host,omitempty"`
+}
+
+// UploadImageSpec represents the request body for uploading an image, such
+// as a cloud-init/Ignition ISO built outside the server's own rendering
+// path (see CreateCloudInitISO).
+type UploadImageSpec struct {
+	Name     string   `json:"name"`
+	OSFamily OSFamily `json:"os_family,omitempty"`
+
+	// Content is the image's raw bytes. UploadImage base64-encodes it
+	// before sending, since this client's requests are JSON-only (see
+	// Client.doRequest) rather than multipart.
+	Content []byte `json:"-"`
+}
+
+// UploadImage uploads spec.Content and registers it as an image the server
+// can subsequently attach to a VM, e.g. as spec for CreateCDROM's
+// SourceISO or a DiskSpec's ImageName.
+func (c *Client) UploadImage(ctx context.Context, spec *UploadImageSpec) (*Image, error) {
+	body, err := json.Marshal(struct {
+		Name          string   `json:"name"`
+		OSFamily      OSFamily `json:"os_family,omitempty"`
+		ContentBase64 string   `json:"content_base64"`
+	}{
+		Name:          spec.Name,
+		OSFamily:      spec.OSFamily,
+		ContentBase64: base64.StdEncoding.EncodeToString(spec.Content),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal image upload: %w", err)
+	}
+
+	respBody, err := c.Post(ctx, PathImages, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var image Image
+	if err := json.Unmarshal(respBody, &image); err != nil {
+		return nil, fmt.Errorf("failed to parse image response: %w", err)
+	}
+
+	return &image, nil
+}
+
+// ListImages returns the golden/uploaded images the server knows about.
+// When osFamily is non-empty it's sent as a query param so the server
+// filters server-side; callers should only pass it when
+// Capabilities.ImageOSFamilyFilter is true, since older servers don't
+// understand the param and would ignore it silently.
+func (c *Client) ListImages(ctx context.Context, osFamily OSFamily) ([]Image, error) {
+	path := PathImages
+	if osFamily != "" {
+		path = fmt.Sprintf("%s?os_family=%s", path, url.QueryEscape(string(osFamily)))
+	}
+
+	respBody, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var images []Image
+	if err := json.Unmarshal(respBody, &images); err != nil {
+		return nil, fmt.Errorf("failed to parse images response: %w", err)
+	}
+
+	return images, nil
+}
+
+// CreateVMWithCloudInit creates a VM and attaches a cloud-init ISO to it,
+// waiting on each step's job in turn so cloudInit.VMID can be filled in
+// from the VM create job's result before the attach is submitted. It
+// returns the attach job's result, since that's the one whose completion
+// means the VM is actually ready to boot with its customization in place.
+//
+// This is the bootstrapping path for guests that don't have an agent
+// installed yet, which VMInitializationRequest's post-boot initialize
+// endpoint requires; a cloud-init ISO is read by the guest's own
+// cloud-init/Ignition datasource on first boot instead.
+func (c *Client) CreateVMWithCloudInit(ctx context.Context, spec *VmSpec, cloudInit *CloudInitSpec) (*JobResult, error) {
+	createResult, err := c.CreateVM(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.WaitForJob(ctx, createResult.JobID); err != nil {
+		return nil, fmt.Errorf("VM creation failed: %w", err)
+	}
+
+	cloudInit.VMID = createResult.VMID
+
+	attachResult, err := c.CreateCloudInitISO(ctx, cloudInit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach cloud-init ISO: %w", err)
+	}
+
+	if _, err := c.WaitForJob(ctx, attachResult.JobID); err != nil {
+		return nil, fmt.Errorf("cloud-init ISO attach failed: %w", err)
+	}
+
+	return attachResult, nil
+}
@@ -0,0 +1,233 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// pollBackoffStart is the initial delay between poll attempts when
+	// falling back to polling.
+	pollBackoffStart = 500 * time.Millisecond
+
+	// pollBackoffCap is the maximum delay between poll attempts.
+	pollBackoffCap = 10 * time.Second
+
+	// pollBackoffJitter is the +/- fraction of jitter applied to each delay.
+	pollBackoffJitter = 0.2
+)
+
+// JobProgress is a single progress update for a running job, whether it
+// arrived via an SSE event or a poll response.
+type JobProgress struct {
+	Status      JobStatus `json:"status"`
+	ProgressPct int       `json:"progress_pct"`
+	Message     string    `json:"message"`
+	TargetHost  string    `json:"target_host"`
+}
+
+// WaitForJobOptions configures WaitForJob's streaming/polling behavior.
+type WaitForJobOptions struct {
+	// OnProgress, if set, is invoked for every progress update received,
+	// whether streamed over SSE or observed via polling.
+	OnProgress func(JobProgress)
+
+	// MaxPollInterval caps the exponential backoff used when falling back
+	// to polling (SSE has no such interval; it reports whenever the server
+	// emits an event). Defaults to pollBackoffCap if zero.
+	MaxPollInterval time.Duration
+}
+
+// WaitForJob waits for a job to complete, preferring a live event stream
+// and falling back to polling with exponential backoff if the server
+// doesn't support it. The wait respects ctx's deadline instead of an
+// internal hard-coded one; callers that want a bound should use
+// context.WithTimeout.
+func (c *Client) WaitForJob(ctx context.Context, jobID string) (*Job, error) {
+	return c.WaitForJobWithOptions(ctx, jobID, WaitForJobOptions{})
+}
+
+// WaitForJobWithOptions is WaitForJob with progress reporting.
+func (c *Client) WaitForJobWithOptions(ctx context.Context, jobID string, opts WaitForJobOptions) (*Job, error) {
+	job, err := c.streamJobEvents(ctx, jobID, opts)
+	if err == errSSEUnavailable {
+		return c.pollJob(ctx, jobID, opts)
+	}
+	return job, err
+}
+
+// errSSEUnavailable is returned by streamJobEvents when the server didn't
+// respond with an event stream, so the caller should fall back to polling
+// rather than treating it as a terminal error.
+var errSSEUnavailable = fmt.Errorf("server does not support job event streaming")
+
+// streamJobEvents attempts to follow GET /api/v1/jobs/{id}/events as
+// text/event-stream, decoding JobProgress frames until the job reaches a
+// terminal state.
+func (c *Client) streamJobEvents(ctx context.Context, jobID string, opts WaitForJobOptions) (*Job, error) {
+	reqURL := fmt.Sprintf("%s/api/v1/jobs/%s/events", c.serverURL, url.PathEscape(jobID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job events request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errSSEUnavailable
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		return nil, errSSEUnavailable
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+
+	for scanner.Scan() {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+
+		var progress JobProgress
+		if err := json.Unmarshal([]byte(data), &progress); err != nil {
+			tflog.Warn(ctx, "Failed to decode job event", map[string]interface{}{"job_id": jobID, "error": err.Error()})
+			continue
+		}
+
+		tflog.Info(ctx, "Job progress", map[string]interface{}{
+			"job_id":       jobID,
+			"status":       progress.Status,
+			"progress_pct": progress.ProgressPct,
+			"message":      progress.Message,
+		})
+		if opts.OnProgress != nil {
+			opts.OnProgress(progress)
+		}
+
+		switch progress.Status {
+		case JobStatusCompleted:
+			return c.GetJob(ctx, jobID)
+		case JobStatusFailed:
+			job, jobErr := c.GetJob(ctx, jobID)
+			if jobErr != nil {
+				return nil, fmt.Errorf("job %s failed: %s", jobID, progress.Message)
+			}
+			errMsg := progress.Message
+			if job.Error != nil {
+				errMsg = *job.Error
+			}
+			return job, fmt.Errorf("job %s failed: %s", jobID, errMsg)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("job event stream for %s ended unexpectedly: %w", jobID, err)
+	}
+
+	// The stream closed without a terminal status; fall back to polling
+	// from wherever it left off rather than erroring out.
+	return nil, errSSEUnavailable
+}
+
+// GetJob fetches a single job by ID.
+func (c *Client) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	path := fmt.Sprintf(PathJobByID, url.PathEscape(jobID))
+
+	respBody, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	var job Job
+	if err := json.Unmarshal(respBody, &job); err != nil {
+		return nil, fmt.Errorf("failed to parse job response: %w", err)
+	}
+
+	return &job, nil
+}
+
+// pollJob polls a job until it completes or fails, using exponential
+// backoff (starting at pollBackoffStart, capped at pollBackoffCap unless
+// opts.MaxPollInterval overrides it, with +/-20% jitter) instead of the
+// fixed interval the SSE path replaces. The overall deadline is whatever
+// ctx carries; callers that want a bound should wrap ctx with
+// context.WithTimeout.
+func (c *Client) pollJob(ctx context.Context, jobID string, opts WaitForJobOptions) (*Job, error) {
+	delay := pollBackoffStart
+
+	backoffCap := pollBackoffCap
+	if opts.MaxPollInterval > 0 {
+		backoffCap = opts.MaxPollInterval
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(jitter(delay)):
+		}
+
+		job, err := c.GetJob(ctx, jobID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to poll job status: %w", err)
+		}
+
+		progress := JobProgress{Status: job.Status}
+		if job.TargetHost != nil {
+			progress.TargetHost = *job.TargetHost
+		}
+		if opts.OnProgress != nil {
+			opts.OnProgress(progress)
+		}
+
+		switch job.Status {
+		case JobStatusCompleted:
+			return job, nil
+		case JobStatusFailed:
+			errMsg := "job failed"
+			if job.Error != nil {
+				errMsg = *job.Error
+			}
+			return job, fmt.Errorf("job %s failed: %s", jobID, errMsg)
+		case JobStatusPending, JobStatusRunning:
+			// Continue polling with a longer backoff.
+		default:
+			return nil, fmt.Errorf("unknown job status: %s", job.Status)
+		}
+
+		delay *= 2
+		if delay > backoffCap {
+			delay = backoffCap
+		}
+	}
+}
+
+// jitter applies +/-pollBackoffJitter randomness to d.
+func jitter(d time.Duration) time.Duration {
+	spread := float64(d) * pollBackoffJitter
+	offset := (rand.Float64()*2 - 1) * spread
+	return d + time.Duration(offset)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Network represents a virtual switch from the API.
+type Network struct {
+	SwitchID                          string            `json:"switch_id,omitempty"`
+	PortID                            string            `json:"port_id,omitempty"`
+	Name                              string            `json:"name"`
+	Host                              string            `json:"host,omitempty"`
+	SwitchType                        string            `json:"switch_type,omitempty"`
+	NetAdapterName                    string            `json:"net_adapter_name,omitempty"`
+	VlanID                            *int              `json:"vlan_id,omitempty"`
+	MACAddress                        string            `json:"mac_address,omitempty"`
+	MTU                               *int              `json:"mtu,omitempty"`
+	AllowManagementOS                 *bool             `json:"allow_management_os,omitempty"`
+	EnableIOV                         *bool             `json:"enable_iov,omitempty"`
+	EnableEmbeddedTeaming             *bool             `json:"enable_embedded_teaming,omitempty"`
+	BandwidthReservationMode          string            `json:"bandwidth_reservation_mode,omitempty"`
+	DefaultFlowMinimumBandwidthWeight *int              `json:"default_flow_minimum_bandwidth_weight,omitempty"`
+	Tags                              map[string]string `json:"tags,omitempty"`
+	Subnets                           []Subnet          `json:"subnets,omitempty"`
+}
+
+// Subnet represents a subnet configured on a Network.
+type Subnet struct {
+	CIDR        string       `json:"cidr"`
+	Gateway     string       `json:"gateway,omitempty"`
+	DHCPOptions []DHCPOption `json:"dhcp_options,omitempty"`
+}
+
+// DHCPOption is a single DHCP option advertised on a Subnet. Options are
+// modeled as key/value pairs rather than a map because the same key (e.g. a
+// DNS server list) can legitimately appear more than once.
+type DHCPOption struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// VirtualSwitchSpec represents the request body for creating or updating a
+// virtual switch.
+type VirtualSwitchSpec struct {
+	Name                  string `json:"name"`
+	SwitchType            string `json:"switch_type"`
+	Host                  string `json:"host"`
+	NetAdapterName        string `json:"net_adapter_name,omitempty"`
+	AllowManagementOS     *bool  `json:"allow_management_os,omitempty"`
+	EnableIOV             *bool  `json:"enable_iov,omitempty"`
+	EnableEmbeddedTeaming *bool  `json:"enable_embedded_teaming,omitempty"`
+}
+
+// ListNetworks returns virtual switches/networks known to the server. When
+// host is non-empty it's sent as a query param so the server can scope the
+// result set; any other filtering is the caller's responsibility since the
+// API doesn't support it natively.
+func (c *Client) ListNetworks(ctx context.Context, host string) ([]Network, error) {
+	path := PathNetworks
+	if host != "" {
+		path = fmt.Sprintf("%s?host=%s", path, url.QueryEscape(host))
+	}
+
+	respBody, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var networks []Network
+	if err := json.Unmarshal(respBody, &networks); err != nil {
+		return nil, fmt.Errorf("failed to parse networks response: %w", err)
+	}
+
+	return networks, nil
+}
+
+// GetNetwork returns a specific virtual switch by ID.
+func (c *Client) GetNetwork(ctx context.Context, switchID string) (*Network, error) {
+	path := fmt.Sprintf(PathNetworkByID, url.PathEscape(switchID))
+	respBody, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var n Network
+	if err := json.Unmarshal(respBody, &n); err != nil {
+		return nil, fmt.Errorf("failed to parse network response: %w", err)
+	}
+
+	return &n, nil
+}
+
+// CreateNetwork creates a new virtual switch.
+func (c *Client) CreateNetwork(ctx context.Context, spec *VirtualSwitchSpec) (*Network, error) {
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal virtual switch spec: %w", err)
+	}
+
+	respBody, err := c.Post(ctx, PathNetworks, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var n Network
+	if err := json.Unmarshal(respBody, &n); err != nil {
+		return nil, fmt.Errorf("failed to parse network response: %w", err)
+	}
+
+	return &n, nil
+}
+
+// UpdateNetwork updates an existing virtual switch.
+func (c *Client) UpdateNetwork(ctx context.Context, switchID string, spec *VirtualSwitchSpec) (*Network, error) {
+	path := fmt.Sprintf(PathNetworkByID, url.PathEscape(switchID))
+
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal virtual switch spec: %w", err)
+	}
+
+	respBody, err := c.Put(ctx, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var n Network
+	if err := json.Unmarshal(respBody, &n); err != nil {
+		return nil, fmt.Errorf("failed to parse network response: %w", err)
+	}
+
+	return &n, nil
+}
+
+// DeleteNetwork deletes a virtual switch.
+func (c *Client) DeleteNetwork(ctx context.Context, switchID string) error {
+	path := fmt.Sprintf(PathNetworkByID, url.PathEscape(switchID))
+	_, err := c.Delete(ctx, path)
+	return err
+}
@@ -0,0 +1,84 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// powerAction identifies a VM power-state transition.
+type powerAction string
+
+const (
+	powerActionOn       powerAction = "on"
+	powerActionOff      powerAction = "off"
+	powerActionShutdown powerAction = "shutdown"
+	powerActionReboot   powerAction = "reboot"
+	powerActionPause    powerAction = "pause"
+	powerActionResume   powerAction = "resume"
+	powerActionSave     powerAction = "save"
+)
+
+// PowerOnVM starts a stopped or saved virtual machine.
+func (c *Client) PowerOnVM(ctx context.Context, vmID string) (*JobResult, error) {
+	return c.powerAction(ctx, vmID, powerActionOn)
+}
+
+// PowerOffVM powers off a virtual machine immediately, without giving the
+// guest a chance to shut down cleanly. Prefer ShutdownVM when the guest OS
+// supports ACPI shutdown.
+func (c *Client) PowerOffVM(ctx context.Context, vmID string) (*JobResult, error) {
+	return c.powerAction(ctx, vmID, powerActionOff)
+}
+
+// ShutdownVM requests a graceful guest OS shutdown via ACPI. The guest must
+// have integration services' shutdown service enabled.
+func (c *Client) ShutdownVM(ctx context.Context, vmID string) (*JobResult, error) {
+	return c.powerAction(ctx, vmID, powerActionShutdown)
+}
+
+// RebootVM requests a graceful guest OS restart via ACPI. The guest must
+// have integration services' shutdown service enabled.
+func (c *Client) RebootVM(ctx context.Context, vmID string) (*JobResult, error) {
+	return c.powerAction(ctx, vmID, powerActionReboot)
+}
+
+// PauseVM suspends a running virtual machine's execution in place, leaving
+// its memory allocated.
+func (c *Client) PauseVM(ctx context.Context, vmID string) (*JobResult, error) {
+	return c.powerAction(ctx, vmID, powerActionPause)
+}
+
+// ResumeVM resumes a paused virtual machine.
+func (c *Client) ResumeVM(ctx context.Context, vmID string) (*JobResult, error) {
+	return c.powerAction(ctx, vmID, powerActionResume)
+}
+
+// SaveVM writes a running virtual machine's memory state to disk and powers
+// it off, so a later PowerOnVM resumes exactly where it left off.
+func (c *Client) SaveVM(ctx context.Context, vmID string) (*JobResult, error) {
+	return c.powerAction(ctx, vmID, powerActionSave)
+}
+
+// powerAction POSTs a power-state transition for vmID and returns the job
+// tracking it.
+func (c *Client) powerAction(ctx context.Context, vmID string, action powerAction) (*JobResult, error) {
+	path := fmt.Sprintf(PathVMPower, url.PathEscape(vmID), action)
+
+	respBody, err := c.Post(ctx, path, bytes.NewReader(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
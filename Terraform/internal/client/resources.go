@@ -40,6 +40,7 @@ type VM struct {
 	Name                 string   `json:"name"`
 	Host                 string   `json:"host"`
 	State                VMState  `json:"state,omitempty"`
+	Template             bool     `json:"template,omitempty"`
 	CPUCores             int      `json:"cpu_cores"`
 	MemoryGB             float64  `json:"memory_gb,omitempty"`
 	MemoryStartupGB      *float64 `json:"memory_startup_gb,omitempty"`
@@ -67,21 +68,32 @@ type VmSpec struct {
 
 // Disk represents a virtual disk from the API.
 type Disk struct {
-	ID         string   `json:"id,omitempty"`
-	Name       string   `json:"name,omitempty"`
-	Path       string   `json:"path,omitempty"`
-	Type       string   `json:"type,omitempty"`
-	SizeGB     *float64 `json:"size_gb,omitempty"`
-	FileSizeGB *float64 `json:"file_size_gb,omitempty"`
+	ID                 string   `json:"id,omitempty"`
+	Name               string   `json:"name,omitempty"`
+	Path               string   `json:"path,omitempty"`
+	Type               string   `json:"type,omitempty"`
+	SizeGB             *float64 `json:"size_gb,omitempty"`
+	FileSizeGB         *float64 `json:"file_size_gb,omitempty"`
+	ControllerType     string   `json:"controller_type,omitempty"`
+	ControllerNumber   *int     `json:"controller_number,omitempty"`
+	ControllerLocation *int     `json:"controller_location,omitempty"`
+	Provisioning       string   `json:"provisioning,omitempty"`
+	MaxIOPS            *int     `json:"max_iops,omitempty"`
+	MinIOPS            *int     `json:"min_iops,omitempty"`
 }
 
 // DiskSpec represents the request body for creating/attaching a disk.
 type DiskSpec struct {
-	VMID           string `json:"vm_id,omitempty"`
-	ImageName      string `json:"image_name,omitempty"`
-	DiskSizeGB     int    `json:"disk_size_gb,omitempty"`
-	DiskType       string `json:"disk_type,omitempty"`
-	ControllerType string `json:"controller_type,omitempty"`
+	VMID               string `json:"vm_id,omitempty"`
+	ImageName          string `json:"image_name,omitempty"`
+	DiskSizeGB         int    `json:"disk_size_gb,omitempty"`
+	DiskType           string `json:"disk_type,omitempty"`
+	ControllerType     string `json:"controller_type,omitempty"`
+	ControllerNumber   *int   `json:"controller_number,omitempty"`
+	ControllerLocation *int   `json:"controller_location,omitempty"`
+	Provisioning       string `json:"provisioning,omitempty"`
+	MaxIOPS            *int   `json:"max_iops,omitempty"`
+	MinIOPS            *int   `json:"min_iops,omitempty"`
 }
 
 // NIC represents a network adapter from the API.
@@ -99,12 +111,73 @@ type NicSpec struct {
 	VMID        string `json:"vm_id,omitempty"`
 	Network     string `json:"network"`
 	AdapterName string `json:"adapter_name,omitempty"`
+
+	// AdapterType is "synthetic" (default) or "legacy"; Hyper-V can't
+	// hot-swap between the two, so the provider forces replacement on
+	// change rather than sending an update for this field.
+	AdapterType      string `json:"adapter_type,omitempty"`
+	DHCPGuard        *bool  `json:"dhcp_guard,omitempty"`
+	RouterGuard      *bool  `json:"router_guard,omitempty"`
+	MACSpoofGuard    *bool  `json:"mac_spoof_guard,omitempty"`
+	MACAddress       string `json:"mac_address,omitempty"`
+	MinBandwidthMbps *int   `json:"min_bandwidth_mbps,omitempty"`
+	MaxBandwidthMbps *int   `json:"max_bandwidth_mbps,omitempty"`
+
+	// VlanID and VlanTrunk are mutually exclusive: VlanID puts the adapter
+	// in access mode, VlanTrunk in trunk mode.
+	VlanID    *int  `json:"vlan_id,omitempty"`
+	VlanTrunk []int `json:"vlan_trunk,omitempty"`
+
+	SRIOV        *bool `json:"sr_iov,omitempty"`
+	VMQ          *bool `json:"vmq,omitempty"`
+	DeviceNaming *bool `json:"device_naming,omitempty"`
+
+	// IPConfigs carries the adapter's IP configuration entries (primary
+	// plus any secondary addresses/VIPs). Addresses allocated from the
+	// provider's ipam block are already resolved to concrete values by the
+	// time a NicSpec is built, same as any statically configured address.
+	IPConfigs []NicIPConfigSpec `json:"ipconfigs,omitempty"`
+}
+
+// NicIPConfigSpec represents one ipconfig entry of a NicSpec.
+type NicIPConfigSpec struct {
+	Primary      bool              `json:"primary,omitempty"`
+	SearchSuffix string            `json:"search_suffix,omitempty"`
+	IPv4         *NicIPAddressSpec `json:"ipv4,omitempty"`
+	IPv6         *NicIPAddressSpec `json:"ipv6,omitempty"`
+}
+
+// NicIPAddressSpec represents the IPv4 or IPv6 configuration of a
+// NicIPConfigSpec entry.
+type NicIPAddressSpec struct {
+	Mode              string   `json:"mode,omitempty"`
+	Address           string   `json:"address,omitempty"`
+	Gateway           string   `json:"gateway,omitempty"`
+	DNS               []string `json:"dns,omitempty"`
+	PrivacyExtensions *bool    `json:"privacy_extensions,omitempty"`
+	AcceptRA          *bool    `json:"accept_ra,omitempty"`
+}
+
+// CDROM represents a virtual optical drive from the API.
+type CDROM struct {
+	ID           string `json:"id,omitempty"`
+	SourceISO    string `json:"source_iso,omitempty"`
+	StorageClass string `json:"storage_class,omitempty"`
+	EjectOnBoot  bool   `json:"eject_on_boot,omitempty"`
+}
+
+// CDROMSpec represents the request body for attaching a CD/DVD drive.
+type CDROMSpec struct {
+	VMID         string `json:"vm_id,omitempty"`
+	SourceISO    string `json:"source_iso"`
+	StorageClass string `json:"storage_class,omitempty"`
+	EjectOnBoot  bool   `json:"eject_on_boot,omitempty"`
 }
 
 // VMInitializationRequest represents the guest initialization configuration.
 type VMInitializationRequest struct {
-	TargetHost         string                 `json:"target_host"`
-	GuestConfiguration map[string]interface{} `json:"guest_configuration"`
+	TargetHost         string              `json:"target_host"`
+	GuestConfiguration *GuestCustomization `json:"guest_configuration"`
 }
 
 // ListVMs returns all virtual machines.
@@ -210,6 +283,30 @@ func (c *Client) DeleteDisk(ctx context.Context, diskID string) (*JobResult, err
 	return &result, nil
 }
 
+// ResizeDisk changes a disk's size. The server rejects the request if
+// newSizeGB is smaller than the disk's current size.
+func (c *Client) ResizeDisk(ctx context.Context, diskID string, newSizeGB int) (*JobResult, error) {
+	body, err := json.Marshal(struct {
+		DiskSizeGB int `json:"disk_size_gb"`
+	}{DiskSizeGB: newSizeGB})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal disk resize request: %w", err)
+	}
+
+	path := fmt.Sprintf(PathDiskByID, url.PathEscape(diskID))
+	respBody, err := c.Patch(ctx, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
+
 // CreateNIC creates a new network adapter and optionally attaches it to a VM.
 func (c *Client) CreateNIC(ctx context.Context, spec *NicSpec) (*JobResult, error) {
 	body, err := json.Marshal(spec)
@@ -246,8 +343,53 @@ func (c *Client) DeleteNIC(ctx context.Context, nicID string) (*JobResult, error
 	return &result, nil
 }
 
-// InitializeVM applies guest configuration to a VM.
+// CreateCDROM attaches a new CD/DVD drive, optionally mounting an ISO from
+// a configured image store, and optionally attaches it to a VM.
+func (c *Client) CreateCDROM(ctx context.Context, spec *CDROMSpec) (*JobResult, error) {
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CD-ROM spec: %w", err)
+	}
+
+	respBody, err := c.Post(ctx, PathCDROMs, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteCDROM detaches and deletes a CD/DVD drive.
+func (c *Client) DeleteCDROM(ctx context.Context, cdromID string) (*JobResult, error) {
+	path := fmt.Sprintf(PathCDROMByID, url.PathEscape(cdromID))
+	respBody, err := c.Delete(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// InitializeVM applies guest configuration to a VM. If req.GuestConfiguration
+// is set, it's validated client-side first so malformed customization is
+// rejected immediately instead of round-tripping to a failed job.
 func (c *Client) InitializeVM(ctx context.Context, vmID string, req *VMInitializationRequest) (*JobResult, error) {
+	if req.GuestConfiguration != nil {
+		if err := req.GuestConfiguration.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid guest configuration: %w", err)
+		}
+	}
+
 	path := fmt.Sprintf(PathVMInitialize, url.PathEscape(vmID))
 
 	body, err := json.Marshal(req)
@@ -0,0 +1,59 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// tagUpdateRequest represents the request body for reconciling a resource's
+// tags. Set adds new keys or overwrites existing ones; Unset removes keys
+// present on the server but absent from the caller's desired state.
+type tagUpdateRequest struct {
+	Set   map[string]string `json:"set,omitempty"`
+	Unset []string          `json:"unset,omitempty"`
+}
+
+// ListResourceTags returns the tags currently set on the given resource.
+func (c *Client) ListResourceTags(ctx context.Context, resourceType, resourceID string) (map[string]string, error) {
+	path := fmt.Sprintf(PathResourceTags, url.PathEscape(resourceType), url.PathEscape(resourceID))
+	respBody, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags map[string]string
+	if err := json.Unmarshal(respBody, &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse tags response: %w", err)
+	}
+
+	return tags, nil
+}
+
+// UpdateResourceTags reconciles a resource's tags, setting set and
+// removing unset, and returns the resulting tag map.
+func (c *Client) UpdateResourceTags(ctx context.Context, resourceType, resourceID string, set map[string]string, unset []string) (map[string]string, error) {
+	path := fmt.Sprintf(PathResourceTags, url.PathEscape(resourceType), url.PathEscape(resourceID))
+
+	body, err := json.Marshal(tagUpdateRequest{Set: set, Unset: unset})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tag update request: %w", err)
+	}
+
+	respBody, err := c.Put(ctx, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var tags map[string]string
+	if err := json.Unmarshal(respBody, &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse tags response: %w", err)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// batchOperationKind discriminates which spec field of a batchOperation is
+// populated.
+type batchOperationKind string
+
+const (
+	batchOperationVM   batchOperationKind = "vm"
+	batchOperationDisk batchOperationKind = "disk"
+	batchOperationNIC  batchOperationKind = "nic"
+)
+
+// batchOperation is one step of a Batch, in submission order.
+type batchOperation struct {
+	Kind batchOperationKind `json:"kind"`
+	VM   *VmSpec            `json:"vm,omitempty"`
+	Disk *DiskSpec          `json:"disk,omitempty"`
+	NIC  *NicSpec           `json:"nic,omitempty"`
+}
+
+// Batch accumulates VM/Disk/NIC create operations that belong together as
+// one logical provisioning unit - e.g. a VM plus all of its disks and
+// NICs - and submits them to the server as a single job, instead of N+M+1
+// sequential calls with no way to express that they belong together.
+//
+// This is a different problem from BatchClient: BatchClient coalesces
+// many independent VmSpecs (e.g. from a `count = 50` resource) that
+// happen to land within a short window; a Batch is built and submitted by
+// a single caller for a single logical group of resources, and supports
+// mixed VM/Disk/NIC operations rather than only VMs.
+//
+// A Batch is not safe for concurrent use.
+type Batch struct {
+	client          *Client
+	rollbackOnError bool
+	operations      []batchOperation
+}
+
+// NewBatch creates an empty Batch against c. If rollbackOnError is set,
+// the server best-effort rolls back whatever operations in the batch
+// already completed if a later one fails.
+func NewBatch(c *Client, rollbackOnError bool) *Batch {
+	return &Batch{client: c, rollbackOnError: rollbackOnError}
+}
+
+// AddVM queues a VM create as the next operation in the batch.
+func (b *Batch) AddVM(spec *VmSpec) *Batch {
+	b.operations = append(b.operations, batchOperation{Kind: batchOperationVM, VM: spec})
+	return b
+}
+
+// AddDisk queues a disk create/attach as the next operation in the batch.
+func (b *Batch) AddDisk(spec *DiskSpec) *Batch {
+	b.operations = append(b.operations, batchOperation{Kind: batchOperationDisk, Disk: spec})
+	return b
+}
+
+// AddNIC queues a NIC create/attach as the next operation in the batch.
+func (b *Batch) AddNIC(spec *NicSpec) *Batch {
+	b.operations = append(b.operations, batchOperation{Kind: batchOperationNIC, NIC: spec})
+	return b
+}
+
+// Submit submits all queued operations to PathBatch as a single job and
+// waits for it to complete, returning each operation's result in the
+// order it was added via AddVM/AddDisk/AddNIC. It is a no-op, returning a
+// nil slice, if nothing was queued.
+func (b *Batch) Submit(ctx context.Context) ([]JobResult, error) {
+	if len(b.operations) == 0 {
+		return nil, nil
+	}
+
+	body, err := json.Marshal(struct {
+		Operations      []batchOperation `json:"operations"`
+		RollbackOnError bool             `json:"rollback_on_error,omitempty"`
+	}{
+		Operations:      b.operations,
+		RollbackOnError: b.rollbackOnError,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal batch: %w", err)
+	}
+
+	respBody, err := b.client.Post(ctx, PathBatch, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var batchResp struct {
+		JobID   string      `json:"job_id"`
+		Results []JobResult `json:"results"`
+	}
+	if err := json.Unmarshal(respBody, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to parse batch response: %w", err)
+	}
+
+	if batchResp.JobID != "" {
+		if _, err := b.client.WaitForJob(ctx, batchResp.JobID); err != nil {
+			return nil, fmt.Errorf("batch job %s failed: %w", batchResp.JobID, err)
+		}
+	}
+
+	return batchResp.Results, nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// APIVersionHeader is the response header the server may set on /healthz to
+// advertise its version without requiring a round-trip to PathVersion.
+const APIVersionHeader = "TFP-API-Version"
+
+// Capabilities describes optional server features gated on the connected
+// server's RemoteAPIVersion. Resources and data sources should check these
+// instead of re-parsing a version string themselves.
+type Capabilities struct {
+	// ImageOSFamilyFilter indicates the server honors an os_family query
+	// parameter on PathImages. Introduced in v1.2.
+	ImageOSFamilyFilter bool
+
+	// LinkedClone indicates the server accepts the linked field when
+	// cloning a VM (see CloneVM). Introduced in v1.5.
+	LinkedClone bool
+}
+
+// versionRequirement records the minimum server version a capability needs,
+// so ErrUnsupportedFeature can report it without the caller having to know.
+type versionRequirement struct {
+	feature string
+	major   int
+	minor   int
+}
+
+var (
+	requireImageOSFamilyFilter = versionRequirement{feature: "the os_family filter on the images data source", major: 1, minor: 2}
+	requireLinkedClone         = versionRequirement{feature: "linked_clone", major: 1, minor: 5}
+)
+
+func (r versionRequirement) String() string {
+	return fmt.Sprintf("v%d.%d", r.major, r.minor)
+}
+
+// ErrUnsupportedFeature is returned when a caller requests a capability the
+// connected server doesn't advertise, so callers can surface a clean
+// diagnostic instead of letting a 400 from the API bubble up.
+type ErrUnsupportedFeature struct {
+	Requirement   versionRequirement
+	ServerVersion string
+}
+
+func (e *ErrUnsupportedFeature) Error() string {
+	return fmt.Sprintf("feature %q requires Aether-V server >= %s, connected server is %s", e.Requirement.feature, e.Requirement, e.ServerVersion)
+}
+
+// RemoteAPIVersion returns the semantic version reported by the connected
+// server, fetching and caching it on first use. It first tries
+// GET /api/v1/version, falling back to the TFP-API-Version header on
+// /healthz for servers that predate the dedicated version endpoint.
+func (c *Client) RemoteAPIVersion(ctx context.Context) (string, error) {
+	c.versionOnce.Do(func() {
+		c.apiVersion, c.apiVersionErr = c.fetchAPIVersion(ctx)
+	})
+	return c.apiVersion, c.apiVersionErr
+}
+
+// Capabilities returns the set of optional features the connected server
+// advertises, derived from RemoteAPIVersion.
+func (c *Client) Capabilities(ctx context.Context) (Capabilities, error) {
+	v, err := c.RemoteAPIVersion(ctx)
+	if err != nil {
+		return Capabilities{}, err
+	}
+
+	return Capabilities{
+		ImageOSFamilyFilter: versionAtLeast(v, requireImageOSFamilyFilter),
+		LinkedClone:         versionAtLeast(v, requireLinkedClone),
+	}, nil
+}
+
+// requireCapability returns an *ErrUnsupportedFeature if the connected
+// server doesn't meet req, and nil otherwise.
+func (c *Client) requireCapability(ctx context.Context, req versionRequirement, have bool) error {
+	if have {
+		return nil
+	}
+
+	v, err := c.RemoteAPIVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	return &ErrUnsupportedFeature{Requirement: req, ServerVersion: v}
+}
+
+func (c *Client) fetchAPIVersion(ctx context.Context) (string, error) {
+	if body, err := c.Get(ctx, PathVersion); err == nil {
+		var versionResp struct {
+			Version string `json:"version"`
+		}
+		if err := json.Unmarshal(body, &versionResp); err == nil && versionResp.Version != "" {
+			return versionResp.Version, nil
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.serverURL+"/healthz", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create version probe request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to probe server version: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	headerVersion := resp.Header.Get(APIVersionHeader)
+	if headerVersion == "" {
+		return "", fmt.Errorf("server did not report an API version via %s or the %s header on /healthz", PathVersion, APIVersionHeader)
+	}
+
+	return headerVersion, nil
+}
+
+// versionAtLeast reports whether v (a semantic version, optionally prefixed
+// with "v") is at least req.major.req.minor. An unparseable version is
+// treated as not meeting the requirement rather than erroring, since the
+// caller already has a version string to show the user.
+func versionAtLeast(v string, req versionRequirement) bool {
+	major, minor, _, err := parseVersion(v)
+	if err != nil {
+		return false
+	}
+
+	if major != req.major {
+		return major > req.major
+	}
+
+	return minor >= req.minor
+}
+
+func parseVersion(v string) (major, minor, patch int, err error) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+
+	parts := strings.SplitN(v, ".", 3)
+	if len(parts) == 0 || parts[0] == "" {
+		return 0, 0, 0, fmt.Errorf("invalid version %q", v)
+	}
+
+	if major, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid version %q: %w", v, err)
+	}
+	if len(parts) > 1 {
+		if minor, err = strconv.Atoi(parts[1]); err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid version %q: %w", v, err)
+		}
+	}
+	if len(parts) > 2 {
+		if patch, err = strconv.Atoi(strings.SplitN(parts[2], "-", 2)[0]); err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid version %q: %w", v, err)
+		}
+	}
+
+	return major, minor, patch, nil
+}
+
+// versionState holds the cached RemoteAPIVersion result. It's embedded in
+// Client rather than inlined so the zero value of Client (used in tests)
+// still behaves correctly.
+type versionState struct {
+	versionOnce   sync.Once
+	apiVersion    string
+	apiVersionErr error
+}
@@ -0,0 +1,51 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// VMUpdateSpec represents the request body for reconfiguring an existing
+// VM's CPU and memory. Fields are left zero-valued/omitted to leave the
+// current setting unchanged.
+type VMUpdateSpec struct {
+	CPUCores             int      `json:"cpu_cores,omitempty"`
+	MemoryStartupGB      *float64 `json:"memory_startup_gb,omitempty"`
+	DynamicMemoryEnabled *bool    `json:"dynamic_memory_enabled,omitempty"`
+	MemoryMinGB          *float64 `json:"memory_min_gb,omitempty"`
+	MemoryMaxGB          *float64 `json:"memory_max_gb,omitempty"`
+
+	// ForcePowerCycle instructs the server to stop the VM, apply fields
+	// that can't be hot-added while it's running, and start it back up
+	// again as one job, instead of erroring out.
+	ForcePowerCycle bool `json:"force_power_cycle,omitempty"`
+}
+
+// UpdateVM reconfigures an existing VM's CPU and memory. If the VM is
+// running and a requested field requires power-off to apply, the server
+// returns a job error unless spec.ForcePowerCycle is set.
+func (c *Client) UpdateVM(ctx context.Context, vmID string, spec *VMUpdateSpec) (*JobResult, error) {
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VM update spec: %w", err)
+	}
+
+	path := fmt.Sprintf(PathVMByID, url.PathEscape(vmID))
+	respBody, err := c.Patch(ctx, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse job result: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ipam resolves network_if.ipconfig entries with allocation = "ipam"
+// against an external IP address management system instead of requiring a
+// static address inline in Terraform configuration.
+package ipam
+
+import (
+	"context"
+	"fmt"
+)
+
+// BackendKind selects which IPAM system Client talks to.
+type BackendKind string
+
+const (
+	// BackendPHPIPAM talks to a phpIPAM server's REST API.
+	BackendPHPIPAM BackendKind = "phpipam"
+
+	// BackendNetBox talks to a NetBox server's REST API.
+	BackendNetBox BackendKind = "netbox"
+)
+
+// Backend is the pluggable interface a specific IPAM system implements.
+type Backend interface {
+	// Allocate reserves the next free address in subnet (a CIDR prefix or,
+	// for backends that key off a name instead, the network's identifier)
+	// and returns it.
+	Allocate(ctx context.Context, subnet string) (string, error)
+
+	// Release returns a previously allocated address to the pool.
+	Release(ctx context.Context, address string) error
+}
+
+// Config describes how to reach and authenticate to an IPAM backend. Only
+// the fields relevant to Backend need to be populated.
+type Config struct {
+	Backend BackendKind
+
+	// Address is the IPAM server's base URL, e.g. "https://ipam.example.com".
+	Address string
+
+	// Token authenticates to the backend (a phpIPAM API token or a NetBox
+	// API token).
+	Token string
+
+	// AppID is the phpIPAM API application ID. Required when Backend is
+	// BackendPHPIPAM.
+	AppID string
+}
+
+// Client resolves and releases addresses against whichever Backend its
+// Config selects.
+type Client struct {
+	backend Backend
+}
+
+// NewClient creates a Client, dispatching to the backend Config.Backend
+// selects.
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("ipam address is required")
+	}
+
+	var backend Backend
+	switch cfg.Backend {
+	case BackendPHPIPAM, "":
+		if cfg.AppID == "" {
+			return nil, fmt.Errorf("ipam.app_id is required when backend = \"phpipam\"")
+		}
+		backend = newPHPIPAMBackend(cfg)
+
+	case BackendNetBox:
+		backend = newNetBoxBackend(cfg)
+
+	default:
+		return nil, fmt.Errorf("unknown ipam backend %q", cfg.Backend)
+	}
+
+	return &Client{backend: backend}, nil
+}
+
+// Allocate reserves the next free address in subnet.
+func (c *Client) Allocate(ctx context.Context, subnet string) (string, error) {
+	address, err := c.backend.Allocate(ctx, subnet)
+	if err != nil {
+		return "", fmt.Errorf("failed to allocate ipam address in %q: %w", subnet, err)
+	}
+	return address, nil
+}
+
+// Release returns address to the pool.
+func (c *Client) Release(ctx context.Context, address string) error {
+	if err := c.backend.Release(ctx, address); err != nil {
+		return fmt.Errorf("failed to release ipam address %q: %w", address, err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package ipam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// netBoxBackend allocates and releases addresses against a NetBox server's
+// REST API (https://docs.netbox.dev/en/stable/integrations/rest-api/),
+// using its "available IPs" endpoint for a prefix.
+type netBoxBackend struct {
+	address    string
+	token      string
+	httpClient *http.Client
+}
+
+func newNetBoxBackend(cfg Config) *netBoxBackend {
+	return &netBoxBackend{
+		address:    strings.TrimRight(cfg.Address, "/"),
+		token:      cfg.Token,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Allocate requests the next available address from the prefix identified
+// by prefixCIDR (e.g. "10.0.1.0/24").
+func (b *netBoxBackend) Allocate(ctx context.Context, prefixCIDR string) (string, error) {
+	prefixID, err := b.prefixID(ctx, prefixCIDR)
+	if err != nil {
+		return "", err
+	}
+
+	respBody, err := b.do(ctx, http.MethodPost, fmt.Sprintf("/ipam/prefixes/%s/available-ips/", prefixID), []byte(`{}`))
+	if err != nil {
+		return "", err
+	}
+
+	var parsed struct {
+		Address string `json:"address"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse netbox available-ips response: %w", err)
+	}
+
+	// NetBox returns the address with its prefix length (e.g. "10.0.1.5/24");
+	// callers only want the bare address.
+	address, _, found := strings.Cut(parsed.Address, "/")
+	if !found {
+		address = parsed.Address
+	}
+
+	return address, nil
+}
+
+// Release deletes the IP address object NetBox holds for address.
+func (b *netBoxBackend) Release(ctx context.Context, address string) error {
+	respBody, err := b.do(ctx, http.MethodGet, fmt.Sprintf("/ipam/ip-addresses/?address=%s", url.QueryEscape(address)), nil)
+	if err != nil {
+		return err
+	}
+
+	var parsed struct {
+		Results []struct {
+			ID int `json:"id"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return fmt.Errorf("failed to parse netbox ip-addresses lookup response: %w", err)
+	}
+	if len(parsed.Results) == 0 {
+		return fmt.Errorf("netbox has no record of address %q", address)
+	}
+
+	_, err = b.do(ctx, http.MethodDelete, fmt.Sprintf("/ipam/ip-addresses/%d/", parsed.Results[0].ID), nil)
+	return err
+}
+
+// prefixID looks up the NetBox prefix ID for a CIDR.
+func (b *netBoxBackend) prefixID(ctx context.Context, prefixCIDR string) (string, error) {
+	respBody, err := b.do(ctx, http.MethodGet, fmt.Sprintf("/ipam/prefixes/?prefix=%s", url.QueryEscape(prefixCIDR)), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var parsed struct {
+		Results []struct {
+			ID int `json:"id"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse netbox prefix lookup response: %w", err)
+	}
+	if len(parsed.Results) == 0 {
+		return "", fmt.Errorf("netbox has no prefix matching %q", prefixCIDR)
+	}
+
+	return fmt.Sprintf("%d", parsed.Results[0].ID), nil
+}
+
+// do performs a raw NetBox API request.
+func (b *netBoxBackend) do(ctx context.Context, method, apiPath string, body []byte) ([]byte, error) {
+	reqURL := fmt.Sprintf("%s/api%s", b.address, apiPath)
+
+	var reqBody *bytes.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	} else {
+		reqBody = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Token "+b.token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach netbox at %s: %w", b.address, err)
+	}
+	defer resp.Body.Close()
+
+	respBody := new(bytes.Buffer)
+	if _, err := respBody.ReadFrom(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to read netbox response body: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("netbox request to %s failed with status %d: %s", apiPath, resp.StatusCode, respBody.String())
+	}
+
+	return respBody.Bytes(), nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package ipam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// phpIPAMBackend allocates and releases addresses against a phpIPAM
+// server's REST API (https://phpipam.net/api/api_documentation/), scoped to
+// a single application ID.
+type phpIPAMBackend struct {
+	address    string
+	appID      string
+	token      string
+	httpClient *http.Client
+}
+
+func newPHPIPAMBackend(cfg Config) *phpIPAMBackend {
+	return &phpIPAMBackend{
+		address:    strings.TrimRight(cfg.Address, "/"),
+		appID:      cfg.AppID,
+		token:      cfg.Token,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Allocate requests the first free address in the subnet identified by
+// subnetCIDR (e.g. "10.0.1.0/24").
+func (b *phpIPAMBackend) Allocate(ctx context.Context, subnetCIDR string) (string, error) {
+	subnetID, err := b.subnetID(ctx, subnetCIDR)
+	if err != nil {
+		return "", err
+	}
+
+	respBody, err := b.do(ctx, http.MethodPost, fmt.Sprintf("/subnets/%s/first_free/", subnetID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var parsed struct {
+		Data string `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse phpipam first_free response: %w", err)
+	}
+
+	return parsed.Data, nil
+}
+
+// Release frees address by searching phpIPAM for its address record and
+// deleting that record.
+func (b *phpIPAMBackend) Release(ctx context.Context, address string) error {
+	respBody, err := b.do(ctx, http.MethodGet, fmt.Sprintf("/addresses/search/%s/", url.PathEscape(address)), nil)
+	if err != nil {
+		return err
+	}
+
+	var parsed struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return fmt.Errorf("failed to parse phpipam address search response: %w", err)
+	}
+	if len(parsed.Data) == 0 {
+		return fmt.Errorf("phpipam has no record of address %q", address)
+	}
+
+	_, err = b.do(ctx, http.MethodDelete, fmt.Sprintf("/addresses/%s/", parsed.Data[0].ID), nil)
+	return err
+}
+
+// subnetID looks up the phpIPAM subnet ID for a CIDR prefix.
+func (b *phpIPAMBackend) subnetID(ctx context.Context, subnetCIDR string) (string, error) {
+	respBody, err := b.do(ctx, http.MethodGet, fmt.Sprintf("/subnets/cidr/%s/", url.PathEscape(subnetCIDR)), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var parsed struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse phpipam subnet lookup response: %w", err)
+	}
+	if len(parsed.Data) == 0 {
+		return "", fmt.Errorf("phpipam has no subnet matching %q", subnetCIDR)
+	}
+
+	return parsed.Data[0].ID, nil
+}
+
+// do performs a raw phpIPAM API request against appID's application scope.
+func (b *phpIPAMBackend) do(ctx context.Context, method, apiPath string, body []byte) ([]byte, error) {
+	reqURL := fmt.Sprintf("%s/api/%s%s", b.address, b.appID, apiPath)
+
+	var reqBody *bytes.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	} else {
+		reqBody = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("token", b.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach phpipam at %s: %w", b.address, err)
+	}
+	defer resp.Body.Close()
+
+	respBody := new(bytes.Buffer)
+	if _, err := respBody.ReadFrom(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to read phpipam response body: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("phpipam request to %s failed with status %d: %s", apiPath, resp.StatusCode, respBody.String())
+	}
+
+	return respBody.Bytes(), nil
+}
@@ -35,12 +35,12 @@ type HostsDataSourceModel struct {
 
 // HostModel describes a Hyper-V host.
 type HostModel struct {
-	Name           types.String `tfsdk:"name"`
-	Status         types.String `tfsdk:"status"`
-	TotalMemoryGB  types.Float64 `tfsdk:"total_memory_gb"`
+	Name              types.String  `tfsdk:"name"`
+	Status            types.String  `tfsdk:"status"`
+	TotalMemoryGB     types.Float64 `tfsdk:"total_memory_gb"`
 	AvailableMemoryGB types.Float64 `tfsdk:"available_memory_gb"`
-	CPUCount       types.Int64  `tfsdk:"cpu_count"`
-	VMCount        types.Int64  `tfsdk:"vm_count"`
+	CPUCount          types.Int64   `tfsdk:"cpu_count"`
+	VMCount           types.Int64   `tfsdk:"vm_count"`
 }
 
 // ClusterModel describes a failover cluster.
@@ -117,16 +117,18 @@ func (d *HostsDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
+	client := providerData.Client
+
 	d.client = client
 }
 
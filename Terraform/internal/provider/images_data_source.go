@@ -22,15 +22,24 @@ func NewImagesDataSource() datasource.DataSource {
 	return &ImagesDataSource{}
 }
 
+// imagesClient is the subset of *client.Client that ImagesDataSource
+// depends on. Defining it here, at the point of use, lets
+// internal/testing/testclient fake it for unit tests without widening
+// client.Client into an interface everywhere else it's consumed.
+type imagesClient interface {
+	ListImages(ctx context.Context, osFamily client.OSFamily) ([]client.Image, error)
+	Capabilities(ctx context.Context) (client.Capabilities, error)
+}
+
 // ImagesDataSource defines the data source implementation.
 type ImagesDataSource struct {
-	client *client.Client
+	client imagesClient
 }
 
 // ImagesDataSourceModel describes the data source data model.
 type ImagesDataSourceModel struct {
-	OSFamily types.String  `tfsdk:"os_family"`
-	Images   []ImageModel  `tfsdk:"images"`
+	OSFamily types.String `tfsdk:"os_family"`
+	Images   []ImageModel `tfsdk:"images"`
 }
 
 // ImageModel describes a golden image.
@@ -93,16 +102,18 @@ func (d *ImagesDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
+	client := providerData.Client
+
 	d.client = client
 }
 
@@ -120,17 +131,50 @@ func (d *ImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		"os_family_filter": data.OSFamily.ValueString(),
 	})
 
-	// TODO: Implement images data source read
-	// 1. Call GET /api/v1/images or /api/v1/inventory
-	// 2. Filter by os_family if specified
-	// 3. Parse response and populate model
+	caps, err := d.client.Capabilities(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Determine Server Capabilities",
+			fmt.Sprintf("Could not negotiate the Aether-V server's API version, so the os_family filter will not be sent server-side: %s", err),
+		)
+	}
+
+	tflog.Debug(ctx, "Determined server capabilities", map[string]interface{}{
+		"image_os_family_filter": caps.ImageOSFamilyFilter,
+	})
+
+	osFamily := client.OSFamily(data.OSFamily.ValueString())
+
+	// Only send os_family server-side when the server has negotiated
+	// support for it; older servers ignore unknown query params rather
+	// than erroring, so filtering client-side below is also required.
+	serverFilter := osFamily
+	if !caps.ImageOSFamilyFilter {
+		serverFilter = ""
+	}
 
-	resp.Diagnostics.AddWarning(
-		"Not Implemented",
-		"Images data source read is not yet implemented. Returning empty data.",
-	)
+	images, err := d.client.ListImages(ctx, serverFilter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Images",
+			fmt.Sprintf("Could not list images: %s", err),
+		)
+		return
+	}
 
-	data.Images = []ImageModel{}
+	data.Images = make([]ImageModel, 0, len(images))
+	for _, image := range images {
+		if osFamily != "" && image.OSFamily != osFamily {
+			continue
+		}
+		data.Images = append(data.Images, ImageModel{
+			Name:     types.StringValue(image.Name),
+			Path:     types.StringValue(image.Path),
+			SizeGB:   types.Float64Value(image.SizeGB),
+			OSFamily: types.StringValue(string(image.OSFamily)),
+			Host:     types.StringValue(image.Host),
+		})
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
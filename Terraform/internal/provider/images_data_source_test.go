@@ -0,0 +1,126 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/aetherv/aether-v/terraform/internal/testing/testclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newImagesReadRequest builds a datasource.ReadRequest/ReadResponse pair for
+// ds, bypassing the framework's normal RPC dispatch so Read can be exercised
+// directly against a fake client.
+func newImagesReadRequest(t *testing.T, ds *ImagesDataSource, config ImagesDataSourceModel) (datasource.ReadRequest, *datasource.ReadResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("building schema: %s", schemaResp.Diagnostics)
+	}
+
+	configState := tfsdk.Config{Schema: schemaResp.Schema}
+	if diags := configState.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("building config: %s", diags)
+	}
+
+	req := datasource.ReadRequest{Config: configState}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	return req, resp
+}
+
+func TestImagesDataSourceRead_SendsFilterWhenServerSupportsIt(t *testing.T) {
+	var gotOSFamily client.OSFamily
+	fake := &testclient.FakeClient{
+		CapabilitiesFunc: func(ctx context.Context) (client.Capabilities, error) {
+			return client.Capabilities{ImageOSFamilyFilter: true}, nil
+		},
+		ListImagesFunc: func(ctx context.Context, osFamily client.OSFamily) ([]client.Image, error) {
+			gotOSFamily = osFamily
+			return []client.Image{{Name: "ubuntu-22.04", OSFamily: osFamily}}, nil
+		},
+	}
+	ds := &ImagesDataSource{client: fake}
+
+	req, resp := newImagesReadRequest(t, ds, ImagesDataSourceModel{OSFamily: types.StringValue("linux")})
+	ds.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+	if gotOSFamily != "linux" {
+		t.Fatalf("expected os_family %q to reach ListImages, got %q", "linux", gotOSFamily)
+	}
+}
+
+func TestImagesDataSourceRead_FiltersClientSideWhenServerDoesNotSupportIt(t *testing.T) {
+	var gotOSFamily client.OSFamily
+	var called bool
+	fake := &testclient.FakeClient{
+		CapabilitiesFunc: func(ctx context.Context) (client.Capabilities, error) {
+			return client.Capabilities{ImageOSFamilyFilter: false}, nil
+		},
+		ListImagesFunc: func(ctx context.Context, osFamily client.OSFamily) ([]client.Image, error) {
+			called = true
+			gotOSFamily = osFamily
+			return []client.Image{
+				{Name: "ubuntu-22.04", OSFamily: "linux"},
+				{Name: "windows-2022", OSFamily: "windows"},
+			}, nil
+		},
+	}
+	ds := &ImagesDataSource{client: fake}
+
+	req, resp := newImagesReadRequest(t, ds, ImagesDataSourceModel{OSFamily: types.StringValue("linux")})
+	ds.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+	if !called {
+		t.Fatal("expected ListImages to be called even without server-side filter support")
+	}
+	if gotOSFamily != "" {
+		t.Fatalf("expected no os_family to be sent server-side, got %q", gotOSFamily)
+	}
+
+	var out ImagesDataSourceModel
+	if diags := resp.State.Get(context.Background(), &out); diags.HasError() {
+		t.Fatalf("reading result state: %s", diags)
+	}
+	if len(out.Images) != 1 || out.Images[0].Name.ValueString() != "ubuntu-22.04" {
+		t.Fatalf("expected client-side filtering to leave only the linux image, got %+v", out.Images)
+	}
+}
+
+func TestImagesDataSourceRead_BackendErrorPropagatesAsDiagnostic(t *testing.T) {
+	fake := &testclient.FakeClient{
+		CapabilitiesFunc: func(ctx context.Context) (client.Capabilities, error) {
+			return client.Capabilities{}, nil
+		},
+		ListImagesFunc: func(ctx context.Context, osFamily client.OSFamily) ([]client.Image, error) {
+			return nil, errBackendUnavailable
+		},
+	}
+	ds := &ImagesDataSource{client: fake}
+
+	req, resp := newImagesReadRequest(t, ds, ImagesDataSourceModel{})
+	ds.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a backend error to produce a diagnostic, got none")
+	}
+	if got := resp.Diagnostics[0].Summary(); got != "Unable to List Images" {
+		t.Fatalf("unexpected diagnostic summary: %q", got)
+	}
+}
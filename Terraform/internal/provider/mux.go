@@ -0,0 +1,49 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aetherv/aether-v/terraform/internal/provider/sdkv2"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+)
+
+// NewMuxedServer combines the terraform-plugin-framework provider (New, in
+// provider.go) with the terraform-plugin-sdk/v2 provider (sdkv2.New) into a
+// single protocol 6 server. Both providers are configured independently by
+// Terraform core from the same provider block, but since Configure on the
+// framework side builds the shared *client.Client the same way sdkv2's
+// configure does, resources on both sides end up talking to the same
+// server with the same credentials.
+//
+// New resources that need plan-time machinery the framework doesn't expose
+// (CustomizeDiff, ValidateDiagFunc, an Importer with state upgraders) should
+// be added to sdkv2.New's ResourcesMap instead of here.
+func NewMuxedServer(ctx context.Context, version string) (func() tfprotov6.ProviderServer, error) {
+	sdkProvider := sdkv2.New(version)()
+
+	upgradedSDKProvider, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upgrade sdkv2 provider to protocol 6: %w", err)
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(New(version)()),
+		func() tfprotov6.ProviderServer {
+			return upgradedSDKProvider
+		},
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mux server: %w", err)
+	}
+
+	return muxServer.ProviderServer, nil
+}
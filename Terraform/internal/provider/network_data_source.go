@@ -0,0 +1,240 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NetworkDataSource{}
+
+// NewNetworkDataSource creates a new NetworkDataSource.
+func NewNetworkDataSource() datasource.DataSource {
+	return &NetworkDataSource{}
+}
+
+// NetworkDataSource is the singular counterpart to NetworksDataSource: it
+// looks up exactly one virtual switch by name, so modules can reference a
+// specific switch without indexing into a list.
+type NetworkDataSource struct {
+	client *client.Client
+}
+
+// NetworkDataSourceModel describes the data source data model.
+type NetworkDataSourceModel struct {
+	Name                              types.String      `tfsdk:"name"`
+	Host                              types.String      `tfsdk:"host"`
+	SwitchID                          types.String      `tfsdk:"switch_id"`
+	PortID                            types.String      `tfsdk:"port_id"`
+	SwitchType                        types.String      `tfsdk:"switch_type"`
+	VlanID                            types.Int64       `tfsdk:"vlan_id"`
+	MACAddress                        types.String      `tfsdk:"mac_address"`
+	MTU                               types.Int64       `tfsdk:"mtu"`
+	AllowManagementOS                 types.Bool        `tfsdk:"allow_management_os"`
+	BandwidthReservationMode          types.String      `tfsdk:"bandwidth_reservation_mode"`
+	DefaultFlowMinimumBandwidthWeight types.Int64       `tfsdk:"default_flow_minimum_bandwidth_weight"`
+	Tags                              map[string]string `tfsdk:"tags"`
+	Subnets                           []SubnetModel     `tfsdk:"subnets"`
+}
+
+func (d *NetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+func (d *NetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single virtual switch/network by name. Errors if no switch matches, or if `host` is needed to disambiguate multiple switches sharing that name.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The exact name of the virtual switch to look up.",
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Disambiguate by host when more than one host has a switch with this name. If not specified, the switch must be unique across all hosts; the resolved host is then populated here.",
+			},
+			"switch_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The virtual switch's unique ID.",
+			},
+			"port_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The virtual switch's port ID.",
+			},
+			"switch_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The switch type (External, Internal, Private).",
+			},
+			"vlan_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The VLAN ID carried by this switch, if any.",
+			},
+			"mac_address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The switch's MAC address, if any.",
+			},
+			"mtu": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The switch's maximum transmission unit, in bytes.",
+			},
+			"allow_management_os": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the management OS shares this switch.",
+			},
+			"bandwidth_reservation_mode": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The switch's bandwidth reservation mode (e.g. `Absolute`, `Weight`, `None`).",
+			},
+			"default_flow_minimum_bandwidth_weight": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The default minimum bandwidth weight (0-100) assigned to flows on this switch.",
+			},
+			"tags": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags assigned to this switch.",
+			},
+			"subnets": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Subnets configured on this switch.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The subnet in CIDR notation.",
+						},
+						"gateway": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The subnet's gateway address.",
+						},
+						"dhcp_options": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "DHCP options advertised on this subnet.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The DHCP option name.",
+									},
+									"value": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The DHCP option value.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *NetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NetworkDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	host := data.Host.ValueString()
+
+	tflog.Debug(ctx, "Reading network data source", map[string]interface{}{
+		"name": name,
+		"host": host,
+	})
+
+	// Shares the same client.ListNetworks call as NetworksDataSource, so the
+	// two data sources can never drift on what the server considers a match.
+	networks, err := d.client.ListNetworks(ctx, host)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Network",
+			fmt.Sprintf("Could not list networks: %s", err),
+		)
+		return
+	}
+
+	var matches []client.Network
+	for _, n := range networks {
+		if n.Name == name {
+			matches = append(matches, n)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Network Not Found",
+			fmt.Sprintf("No virtual switch named %q was found%s.", name, hostSuffix(host)),
+		)
+		return
+	}
+
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Ambiguous Network",
+			fmt.Sprintf("Found %d virtual switches named %q%s; set host to disambiguate.", len(matches), name, hostSuffix(host)),
+		)
+		return
+	}
+
+	vs := toVirtualSwitchModel(matches[0])
+
+	data.Host = vs.Host
+	data.SwitchID = vs.SwitchID
+	data.PortID = vs.PortID
+	data.SwitchType = vs.SwitchType
+	data.VlanID = vs.VlanID
+	data.MACAddress = vs.MACAddress
+	data.MTU = vs.MTU
+	data.AllowManagementOS = vs.AllowManagementOS
+	data.BandwidthReservationMode = vs.BandwidthReservationMode
+	data.DefaultFlowMinimumBandwidthWeight = vs.DefaultFlowMinimumBandwidthWeight
+	data.Tags = vs.Tags
+	data.Subnets = vs.Subnets
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// hostSuffix renders the optional host disambiguator for error messages.
+func hostSuffix(host string) string {
+	if host == "" {
+		return ""
+	}
+	return fmt.Sprintf(" on host %q", host)
+}
@@ -6,6 +6,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/aetherv/aether-v/terraform/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -22,22 +24,60 @@ func NewNetworksDataSource() datasource.DataSource {
 	return &NetworksDataSource{}
 }
 
+// networksClient is the subset of *client.Client that NetworksDataSource
+// depends on. Defining it here, at the point of use, lets
+// internal/testing/testclient fake it for unit tests without widening
+// client.Client into an interface everywhere else it's consumed.
+type networksClient interface {
+	ListNetworks(ctx context.Context, host string) ([]client.Network, error)
+}
+
 // NetworksDataSource defines the data source implementation.
 type NetworksDataSource struct {
-	client *client.Client
+	client networksClient
 }
 
 // NetworksDataSourceModel describes the data source data model.
 type NetworksDataSourceModel struct {
-	Host     types.String          `tfsdk:"host"`
-	Networks []VirtualSwitchModel  `tfsdk:"networks"`
+	Host       types.String         `tfsdk:"host"`
+	SwitchID   types.String         `tfsdk:"switch_id"`
+	PortID     types.String         `tfsdk:"port_id"`
+	Name       types.String         `tfsdk:"name"`
+	SwitchType types.String         `tfsdk:"switch_type"`
+	VlanID     types.Int64          `tfsdk:"vlan_id"`
+	MACAddress types.String         `tfsdk:"mac_address"`
+	Tags       map[string]string    `tfsdk:"tags"`
+	Networks   []VirtualSwitchModel `tfsdk:"networks"`
 }
 
 // VirtualSwitchModel describes a virtual switch.
 type VirtualSwitchModel struct {
-	Name        types.String `tfsdk:"name"`
-	SwitchType  types.String `tfsdk:"switch_type"`
-	Host        types.String `tfsdk:"host"`
+	SwitchID                          types.String      `tfsdk:"switch_id"`
+	PortID                            types.String      `tfsdk:"port_id"`
+	Name                              types.String      `tfsdk:"name"`
+	SwitchType                        types.String      `tfsdk:"switch_type"`
+	Host                              types.String      `tfsdk:"host"`
+	VlanID                            types.Int64       `tfsdk:"vlan_id"`
+	MACAddress                        types.String      `tfsdk:"mac_address"`
+	MTU                               types.Int64       `tfsdk:"mtu"`
+	AllowManagementOS                 types.Bool        `tfsdk:"allow_management_os"`
+	BandwidthReservationMode          types.String      `tfsdk:"bandwidth_reservation_mode"`
+	DefaultFlowMinimumBandwidthWeight types.Int64       `tfsdk:"default_flow_minimum_bandwidth_weight"`
+	Tags                              map[string]string `tfsdk:"tags"`
+	Subnets                           []SubnetModel     `tfsdk:"subnets"`
+}
+
+// SubnetModel describes a subnet configured on a virtual switch.
+type SubnetModel struct {
+	CIDR        types.String      `tfsdk:"cidr"`
+	Gateway     types.String      `tfsdk:"gateway"`
+	DHCPOptions []DHCPOptionModel `tfsdk:"dhcp_options"`
+}
+
+// DHCPOptionModel describes a single DHCP option key/value pair.
+type DHCPOptionModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
 }
 
 func (d *NetworksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,19 +85,67 @@ func (d *NetworksDataSource) Metadata(ctx context.Context, req datasource.Metada
 }
 
 func (d *NetworksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	dhcpOptionAttributes := map[string]schema.Attribute{
+		"key": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The DHCP option name.",
+		},
+		"value": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The DHCP option value.",
+		},
+	}
+
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Retrieves information about available virtual switches/networks.",
+		MarkdownDescription: "Retrieves information about available virtual switches/networks, optionally filtered by host, identifiers, name, type, VLAN, MAC address, or tags. All filters other than `host` are applied client-side and AND together.",
 
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Filter networks by host. If not specified, returns networks from all hosts.",
+				MarkdownDescription: "Filter networks by host. Sent to the server as a query parameter; if not specified, returns networks from all hosts.",
+			},
+			"switch_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter to the virtual switch with this exact switch ID.",
+			},
+			"port_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter to the virtual switch with this exact port ID.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter by switch name, matched as a regular expression.",
+			},
+			"switch_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter by switch type (`External`, `Internal`, `Private`).",
+			},
+			"vlan_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Filter to switches carrying this VLAN ID.",
+			},
+			"mac_address": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter to the switch with this exact MAC address.",
+			},
+			"tags": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Filter to switches carrying all of these tag key/value pairs.",
 			},
 			"networks": schema.ListNestedAttribute{
 				Computed:            true,
-				MarkdownDescription: "List of available virtual switches.",
+				MarkdownDescription: "List of virtual switches matching the given filters.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"switch_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The virtual switch's unique ID.",
+						},
+						"port_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The virtual switch's port ID.",
+						},
 						"name": schema.StringAttribute{
 							Computed:            true,
 							MarkdownDescription: "The virtual switch name.",
@@ -70,6 +158,58 @@ func (d *NetworksDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 							Computed:            true,
 							MarkdownDescription: "The host this switch belongs to.",
 						},
+						"vlan_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The VLAN ID carried by this switch, if any.",
+						},
+						"mac_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The switch's MAC address, if any.",
+						},
+						"mtu": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The switch's maximum transmission unit, in bytes.",
+						},
+						"allow_management_os": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the management OS shares this switch.",
+						},
+						"bandwidth_reservation_mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The switch's bandwidth reservation mode (e.g. `Absolute`, `Weight`, `None`).",
+						},
+						"default_flow_minimum_bandwidth_weight": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The default minimum bandwidth weight (0-100) assigned to flows on this switch.",
+						},
+						"tags": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Tags assigned to this switch.",
+						},
+						"subnets": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Subnets configured on this switch.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"cidr": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The subnet in CIDR notation.",
+									},
+									"gateway": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The subnet's gateway address.",
+									},
+									"dhcp_options": schema.ListNestedAttribute{
+										Computed:            true,
+										MarkdownDescription: "DHCP options advertised on this subnet.",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: dhcpOptionAttributes,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -83,16 +223,18 @@ func (d *NetworksDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
+	client := providerData.Client
+
 	d.client = client
 }
 
@@ -110,18 +252,147 @@ func (d *NetworksDataSource) Read(ctx context.Context, req datasource.ReadReques
 		"host_filter": data.Host.ValueString(),
 	})
 
-	// TODO: Implement networks data source read
-	// 1. Call GET /api/v1/networks or /api/v1/inventory
-	// 2. Filter by host if specified
-	// 3. Parse response and populate model
+	networks, err := d.client.ListNetworks(ctx, data.Host.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Networks",
+			fmt.Sprintf("Could not list networks: %s", err),
+		)
+		return
+	}
 
-	resp.Diagnostics.AddWarning(
-		"Not Implemented",
-		"Networks data source read is not yet implemented. Returning empty data.",
-	)
+	var nameFilter *regexp.Regexp
+	if name := data.Name.ValueString(); name != "" {
+		nameFilter, err = regexp.Compile(name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Name Filter",
+				fmt.Sprintf("name is not a valid regular expression: %s", err),
+			)
+			return
+		}
+	}
 
-	data.Networks = []VirtualSwitchModel{}
+	type namedFilter struct {
+		name   string
+		active bool
+		match  func(client.Network) bool
+	}
+
+	filters := []namedFilter{
+		{"switch_id", !data.SwitchID.IsNull(), func(n client.Network) bool {
+			return n.SwitchID == data.SwitchID.ValueString()
+		}},
+		{"port_id", !data.PortID.IsNull(), func(n client.Network) bool {
+			return n.PortID == data.PortID.ValueString()
+		}},
+		{"name", nameFilter != nil, func(n client.Network) bool {
+			return nameFilter.MatchString(n.Name)
+		}},
+		{"switch_type", !data.SwitchType.IsNull(), func(n client.Network) bool {
+			return strings.EqualFold(n.SwitchType, data.SwitchType.ValueString())
+		}},
+		{"vlan_id", !data.VlanID.IsNull(), func(n client.Network) bool {
+			return n.VlanID != nil && int64(*n.VlanID) == data.VlanID.ValueInt64()
+		}},
+		{"mac_address", !data.MACAddress.IsNull(), func(n client.Network) bool {
+			return strings.EqualFold(n.MACAddress, data.MACAddress.ValueString())
+		}},
+		{"tags", len(data.Tags) > 0, func(n client.Network) bool {
+			for k, v := range data.Tags {
+				if n.Tags[k] != v {
+					return false
+				}
+			}
+			return true
+		}},
+	}
+
+	matched := networks
+	for _, f := range filters {
+		if !f.active {
+			continue
+		}
+
+		before := len(matched)
+		var next []client.Network
+		for _, n := range matched {
+			if f.match(n) {
+				next = append(next, n)
+			}
+		}
+		matched = next
+
+		tflog.Trace(ctx, "Applied network filter", map[string]interface{}{
+			"filter": f.name,
+			"before": before,
+			"after":  len(matched),
+		})
+	}
+
+	if len(matched) == 0 {
+		resp.Diagnostics.AddWarning(
+			"No Matching Networks",
+			"No networks matched the configured filters (host/switch_id/port_id/name/switch_type/vlan_id/mac_address/tags). Returning an empty list rather than an error, since zero matches can be valid, but double-check the filter values if this is unexpected.",
+		)
+	}
+
+	data.Networks = make([]VirtualSwitchModel, 0, len(matched))
+	for _, n := range matched {
+		data.Networks = append(data.Networks, toVirtualSwitchModel(n))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// toVirtualSwitchModel converts an API Network into its Terraform model.
+func toVirtualSwitchModel(n client.Network) VirtualSwitchModel {
+	vs := VirtualSwitchModel{
+		SwitchID:                          types.StringValue(n.SwitchID),
+		PortID:                            types.StringValue(n.PortID),
+		Name:                              types.StringValue(n.Name),
+		SwitchType:                        types.StringValue(n.SwitchType),
+		Host:                              types.StringValue(n.Host),
+		MACAddress:                        types.StringValue(n.MACAddress),
+		BandwidthReservationMode:          types.StringValue(n.BandwidthReservationMode),
+		Tags:                              n.Tags,
+		VlanID:                            types.Int64Null(),
+		MTU:                               types.Int64Null(),
+		AllowManagementOS:                 types.BoolNull(),
+		DefaultFlowMinimumBandwidthWeight: types.Int64Null(),
+	}
+
+	if n.VlanID != nil {
+		vs.VlanID = types.Int64Value(int64(*n.VlanID))
+	}
+	if n.MTU != nil {
+		vs.MTU = types.Int64Value(int64(*n.MTU))
+	}
+	if n.AllowManagementOS != nil {
+		vs.AllowManagementOS = types.BoolValue(*n.AllowManagementOS)
+	}
+	if n.DefaultFlowMinimumBandwidthWeight != nil {
+		vs.DefaultFlowMinimumBandwidthWeight = types.Int64Value(int64(*n.DefaultFlowMinimumBandwidthWeight))
+	}
+
+	vs.Subnets = make([]SubnetModel, 0, len(n.Subnets))
+	for _, s := range n.Subnets {
+		sm := SubnetModel{
+			CIDR:    types.StringValue(s.CIDR),
+			Gateway: types.StringValue(s.Gateway),
+		}
+
+		sm.DHCPOptions = make([]DHCPOptionModel, 0, len(s.DHCPOptions))
+		for _, opt := range s.DHCPOptions {
+			sm.DHCPOptions = append(sm.DHCPOptions, DHCPOptionModel{
+				Key:   types.StringValue(opt.Key),
+				Value: types.StringValue(opt.Value),
+			})
+		}
+
+		vs.Subnets = append(vs.Subnets, sm)
+	}
+
+	return vs
+}
@@ -0,0 +1,137 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/aetherv/aether-v/terraform/internal/testing/testclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newNetworksReadRequest builds a datasource.ReadRequest/ReadResponse pair
+// for ds, bypassing the framework's normal RPC dispatch so Read can be
+// exercised directly against a fake client.
+func newNetworksReadRequest(t *testing.T, ds *NetworksDataSource, config NetworksDataSourceModel) (datasource.ReadRequest, *datasource.ReadResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("building schema: %s", schemaResp.Diagnostics)
+	}
+
+	configState := tfsdk.Config{Schema: schemaResp.Schema}
+	if diags := configState.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("building config: %s", diags)
+	}
+
+	req := datasource.ReadRequest{Config: configState}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	return req, resp
+}
+
+func TestNetworksDataSourceRead_HostFilterApplied(t *testing.T) {
+	var gotHost string
+	fake := &testclient.FakeClient{
+		ListNetworksFunc: func(ctx context.Context, host string) ([]client.Network, error) {
+			gotHost = host
+			return []client.Network{{Name: "lan", Host: host}}, nil
+		},
+	}
+	ds := &NetworksDataSource{client: fake}
+
+	req, resp := newNetworksReadRequest(t, ds, NetworksDataSourceModel{Host: types.StringValue("host-a")})
+	ds.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+	if gotHost != "host-a" {
+		t.Fatalf("expected host filter %q to reach ListNetworks, got %q", "host-a", gotHost)
+	}
+}
+
+func TestNetworksDataSourceRead_HostFilterEmpty(t *testing.T) {
+	var gotHost string
+	var hostWasCalled bool
+	fake := &testclient.FakeClient{
+		ListNetworksFunc: func(ctx context.Context, host string) ([]client.Network, error) {
+			gotHost = host
+			hostWasCalled = true
+			return []client.Network{{Name: "lan", Host: "host-a"}}, nil
+		},
+	}
+	ds := &NetworksDataSource{client: fake}
+
+	req, resp := newNetworksReadRequest(t, ds, NetworksDataSourceModel{})
+	ds.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+	if !hostWasCalled || gotHost != "" {
+		t.Fatalf("expected an empty host filter to reach ListNetworks, got called=%v host=%q", hostWasCalled, gotHost)
+	}
+}
+
+func TestNetworksDataSourceRead_BackendErrorPropagatesAsDiagnostic(t *testing.T) {
+	fake := &testclient.FakeClient{
+		ListNetworksFunc: func(ctx context.Context, host string) ([]client.Network, error) {
+			return nil, errBackendUnavailable
+		},
+	}
+	ds := &NetworksDataSource{client: fake}
+
+	req, resp := newNetworksReadRequest(t, ds, NetworksDataSourceModel{})
+	ds.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a backend error to produce a diagnostic, got none")
+	}
+	if got := resp.Diagnostics[0].Summary(); got != "Unable to Read Networks" {
+		t.Fatalf("unexpected diagnostic summary: %q", got)
+	}
+}
+
+func TestNetworksDataSourceRead_EmptyResultWarns(t *testing.T) {
+	fake := &testclient.FakeClient{
+		ListNetworksFunc: func(ctx context.Context, host string) ([]client.Network, error) {
+			return []client.Network{}, nil
+		},
+	}
+	ds := &NetworksDataSource{client: fake}
+
+	req, resp := newNetworksReadRequest(t, ds, NetworksDataSourceModel{})
+	ds.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %s", resp.Diagnostics)
+	}
+
+	var found bool
+	for _, d := range resp.Diagnostics {
+		if d.Summary() == "No Matching Networks" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected a %q warning, got: %s", "No Matching Networks", resp.Diagnostics)
+	}
+}
+
+// errBackendUnavailable is a sentinel error used by the backend-error test
+// case above.
+var errBackendUnavailable = fakeError("backend unavailable")
+
+type fakeError string
+
+func (e fakeError) Error() string { return string(e) }
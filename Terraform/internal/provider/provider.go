@@ -5,17 +5,42 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/aetherv/aether-v/terraform/internal/ipam"
+	"github.com/aetherv/aether-v/terraform/internal/secrets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// ProviderData is the value threaded through DataSourceData/ResourceData to
+// every data source's and resource's Configure method. Batch is nil when the
+// batching block is disabled (the default).
+type ProviderData struct {
+	Client *client.Client
+	Batch  *client.BatchClient
+
+	// Secrets is nil unless the vault block is configured, in which case
+	// VirtualMachineResource resolves vault_ref-style guest-customization
+	// secrets against it instead of requiring them inline.
+	Secrets *secrets.Client
+
+	// IPAM is nil unless the ipam block is configured, in which case
+	// VirtualMachineResource resolves network_if.ipconfig entries with
+	// allocation = "ipam" against it instead of requiring an address
+	// inline.
+	IPAM *ipam.Client
+}
+
 // Ensure AethervProvider satisfies various provider interfaces.
 var _ provider.Provider = &AethervProvider{}
 
@@ -29,10 +54,59 @@ type AethervProvider struct {
 
 // AethervProviderModel describes the provider data model.
 type AethervProviderModel struct {
-	ServerURL    types.String `tfsdk:"server_url"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	TenantID     types.String `tfsdk:"tenant_id"`
+	ServerURL     types.String `tfsdk:"server_url"`
+	AuthMode      types.String `tfsdk:"auth_mode"`
+	ClientID      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+	TenantID      types.String `tfsdk:"tenant_id"`
+	AuthorityHost types.String `tfsdk:"authority_host"`
+
+	ClientCertificatePath     types.String `tfsdk:"client_certificate_path"`
+	ClientCertificatePassword types.String `tfsdk:"client_certificate_password"`
+
+	OIDCToken        types.String `tfsdk:"oidc_token"`
+	OIDCRequestURL   types.String `tfsdk:"oidc_request_url"`
+	OIDCRequestToken types.String `tfsdk:"oidc_request_token"`
+
+	Batching *BatchingModel `tfsdk:"batching"`
+	Vault    *VaultModel    `tfsdk:"vault"`
+	Ipam     *IPAMModel     `tfsdk:"ipam"`
+}
+
+// BatchingModel describes the provider's "batching" block, which controls
+// request coalescing for count-heavy resource creation (see client.BatchClient).
+type BatchingModel struct {
+	Enabled      types.Bool  `tfsdk:"enabled"`
+	MaxBatchSize types.Int64 `tfsdk:"max_batch_size"`
+	MaxDelayMs   types.Int64 `tfsdk:"max_delay_ms"`
+}
+
+// VaultModel describes the provider's "vault" block, which configures the
+// HashiCorp Vault client VirtualMachineResource resolves vault_ref-style
+// guest-customization secrets against (see internal/secrets).
+type VaultModel struct {
+	Address   types.String `tfsdk:"address"`
+	Namespace types.String `tfsdk:"namespace"`
+	AuthMode  types.String `tfsdk:"auth_mode"`
+	Token     types.String `tfsdk:"token"`
+
+	RoleID   types.String `tfsdk:"role_id"`
+	SecretID types.String `tfsdk:"secret_id"`
+
+	KubernetesRole      types.String `tfsdk:"kubernetes_role"`
+	KubernetesMountPath types.String `tfsdk:"kubernetes_mount_path"`
+	KubernetesTokenPath types.String `tfsdk:"kubernetes_token_path"`
+}
+
+// IPAMModel describes the provider's "ipam" block, which configures the
+// pluggable IP address management backend VirtualMachineResource resolves
+// network_if.ipconfig entries with allocation = "ipam" against (see
+// internal/ipam).
+type IPAMModel struct {
+	Backend types.String `tfsdk:"backend"`
+	Address types.String `tfsdk:"address"`
+	Token   types.String `tfsdk:"token"`
+	AppID   types.String `tfsdk:"app_id"`
 }
 
 func (p *AethervProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -48,18 +122,156 @@ func (p *AethervProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Description: "The URL of the Aether-V server. Can also be set via the AETHERV_SERVER_URL environment variable.",
 				Optional:    true,
 			},
+			"auth_mode": schema.StringAttribute{
+				Description: "How to authenticate to Azure AD: \"client_secret\" (default), \"client_certificate\", \"workload_identity\", \"managed_identity\", \"azure_cli\", or \"oidc\". Can also be set via the AETHERV_AUTH_MODE environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.AuthModeClientSecret),
+						string(client.AuthModeClientCertificate),
+						string(client.AuthModeWorkloadIdentity),
+						string(client.AuthModeManagedIdentity),
+						string(client.AuthModeAzureCLI),
+						string(client.AuthModeOIDC),
+					),
+				},
+			},
 			"client_id": schema.StringAttribute{
-				Description: "The OAuth2 client ID for authentication. Can also be set via the AETHERV_CLIENT_ID environment variable.",
+				Description: "The Azure AD application (client) ID. Can also be set via the AETHERV_CLIENT_ID environment variable.",
 				Optional:    true,
 			},
 			"client_secret": schema.StringAttribute{
-				Description: "The OAuth2 client secret for authentication. Can also be set via the AETHERV_CLIENT_SECRET environment variable.",
+				Description: "The OAuth2 client secret, used when auth_mode = \"client_secret\". Can also be set via the AETHERV_CLIENT_SECRET environment variable.",
 				Optional:    true,
 				Sensitive:   true,
 			},
 			"tenant_id": schema.StringAttribute{
-				Description: "The tenant ID for authentication. Can also be set via the AETHERV_TENANT_ID environment variable.",
+				Description: "The Azure AD tenant ID. Can also be set via the AETHERV_TENANT_ID environment variable.",
+				Optional:    true,
+			},
+			"authority_host": schema.StringAttribute{
+				Description: "Overrides the Azure AD authority host for sovereign clouds, e.g. \"https://login.microsoftonline.us\" (AzureUSGovernment) or \"https://login.partner.microsoftonline.cn\" (AzureChina). Defaults to the public cloud authority. Can also be set via the AETHERV_AUTHORITY_HOST environment variable.",
+				Optional:    true,
+			},
+			"client_certificate_path": schema.StringAttribute{
+				Description: "Path to a PEM file containing the client certificate and private key, used when auth_mode = \"client_certificate\". Can also be set via the AETHERV_CLIENT_CERTIFICATE_PATH environment variable.",
+				Optional:    true,
+			},
+			"client_certificate_password": schema.StringAttribute{
+				Description: "Password for the client certificate's private key. Accepted but not used: the private key in client_certificate_path must be unencrypted. Can also be set via the AETHERV_CLIENT_CERTIFICATE_PASSWORD environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"oidc_token": schema.StringAttribute{
+				Description: "A pre-fetched OIDC token to exchange for an access token, used when auth_mode = \"oidc\". Can also be set via the AETHERV_OIDC_TOKEN environment variable.",
 				Optional:    true,
+				Sensitive:   true,
+			},
+			"oidc_request_url": schema.StringAttribute{
+				Description: "URL to fetch an OIDC token from when oidc_token is not set, e.g. GitHub Actions' ACTIONS_ID_TOKEN_REQUEST_URL. Can also be set via the AETHERV_OIDC_REQUEST_URL or ACTIONS_ID_TOKEN_REQUEST_URL environment variables.",
+				Optional:    true,
+			},
+			"oidc_request_token": schema.StringAttribute{
+				Description: "Bearer token used to authenticate to oidc_request_url, e.g. GitHub Actions' ACTIONS_ID_TOKEN_REQUEST_TOKEN. Can also be set via the AETHERV_OIDC_REQUEST_TOKEN or ACTIONS_ID_TOKEN_REQUEST_TOKEN environment variables.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"batching": schema.SingleNestedBlock{
+				Description: "Controls coalescing of concurrent VM creation requests (e.g. from a count-heavy resource) into fewer server-side jobs.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Whether to coalesce concurrent VM creation requests targeting the same host or cluster. Defaults to false.",
+						Optional:    true,
+					},
+					"max_batch_size": schema.Int64Attribute{
+						Description: "Flush a pending batch once it reaches this many requests. Defaults to 25.",
+						Optional:    true,
+					},
+					"max_delay_ms": schema.Int64Attribute{
+						Description: "Flush a pending batch this many milliseconds after its first request arrived, even if max_batch_size hasn't been reached. Defaults to 100.",
+						Optional:    true,
+					},
+				},
+			},
+			"vault": schema.SingleNestedBlock{
+				Description: "Configures a HashiCorp Vault client that aetherv_virtual_machine resolves vault_ref-style guest-customization secrets (user.password, domain_join.password, ansible.ssh_key) against, instead of requiring them inline in configuration and state.",
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						Description: "The Vault server's base URL, e.g. \"https://vault.example.com:8200\". Required if this block is present. Can also be set via the VAULT_ADDR environment variable.",
+						Optional:    true,
+					},
+					"namespace": schema.StringAttribute{
+						Description: "A Vault Enterprise namespace to operate in. Leave unset for Vault Community Edition or the root namespace. Can also be set via the VAULT_NAMESPACE environment variable.",
+						Optional:    true,
+					},
+					"auth_mode": schema.StringAttribute{
+						Description: "How to authenticate to Vault: \"token\" (default), \"approle\", or \"kubernetes\".",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(secrets.AuthModeToken),
+								string(secrets.AuthModeAppRole),
+								string(secrets.AuthModeKubernetes),
+							),
+						},
+					},
+					"token": schema.StringAttribute{
+						Description: "The Vault token to use, when auth_mode = \"token\". Can also be set via the VAULT_TOKEN environment variable.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"role_id": schema.StringAttribute{
+						Description: "The AppRole role ID, used when auth_mode = \"approle\".",
+						Optional:    true,
+					},
+					"secret_id": schema.StringAttribute{
+						Description: "The AppRole secret ID, used when auth_mode = \"approle\".",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"kubernetes_role": schema.StringAttribute{
+						Description: "The Vault role to authenticate as, used when auth_mode = \"kubernetes\".",
+						Optional:    true,
+					},
+					"kubernetes_mount_path": schema.StringAttribute{
+						Description: "Overrides the kubernetes auth method's mount path. Defaults to \"kubernetes\".",
+						Optional:    true,
+					},
+					"kubernetes_token_path": schema.StringAttribute{
+						Description: "Overrides the path the pod's projected service account token is read from. Defaults to the standard Kubernetes-injected path.",
+						Optional:    true,
+					},
+				},
+			},
+			"ipam": schema.SingleNestedBlock{
+				Description: "Configures a pluggable IP address management backend that aetherv_virtual_machine resolves network_if.ipconfig entries with allocation = \"ipam\" against when the network interface is created.",
+				Attributes: map[string]schema.Attribute{
+					"backend": schema.StringAttribute{
+						Description: "Which IPAM system to talk to: \"phpipam\" (default) or \"netbox\".",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(ipam.BackendPHPIPAM),
+								string(ipam.BackendNetBox),
+							),
+						},
+					},
+					"address": schema.StringAttribute{
+						Description: "The IPAM server's base URL, e.g. \"https://ipam.example.com\". Required if this block is present.",
+						Optional:    true,
+					},
+					"token": schema.StringAttribute{
+						Description: "The API token used to authenticate to the IPAM backend.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"app_id": schema.StringAttribute{
+						Description: "The phpIPAM API application ID. Required when backend = \"phpipam\".",
+						Optional:    true,
+					},
+				},
 			},
 		},
 	}
@@ -76,14 +288,24 @@ func (p *AethervProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	// Default values from environment variables
 	serverURL := os.Getenv("AETHERV_SERVER_URL")
+	authMode := os.Getenv("AETHERV_AUTH_MODE")
 	clientID := os.Getenv("AETHERV_CLIENT_ID")
 	clientSecret := os.Getenv("AETHERV_CLIENT_SECRET")
 	tenantID := os.Getenv("AETHERV_TENANT_ID")
+	authorityHost := os.Getenv("AETHERV_AUTHORITY_HOST")
+	clientCertPath := os.Getenv("AETHERV_CLIENT_CERTIFICATE_PATH")
+	clientCertPassword := os.Getenv("AETHERV_CLIENT_CERTIFICATE_PASSWORD")
+	oidcToken := os.Getenv("AETHERV_OIDC_TOKEN")
+	oidcRequestURL := firstNonEmpty(os.Getenv("AETHERV_OIDC_REQUEST_URL"), os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"))
+	oidcRequestToken := firstNonEmpty(os.Getenv("AETHERV_OIDC_REQUEST_TOKEN"), os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN"))
 
 	// Override with explicit configuration if provided
 	if !config.ServerURL.IsNull() {
 		serverURL = config.ServerURL.ValueString()
 	}
+	if !config.AuthMode.IsNull() {
+		authMode = config.AuthMode.ValueString()
+	}
 	if !config.ClientID.IsNull() {
 		clientID = config.ClientID.ValueString()
 	}
@@ -93,6 +315,28 @@ func (p *AethervProvider) Configure(ctx context.Context, req provider.ConfigureR
 	if !config.TenantID.IsNull() {
 		tenantID = config.TenantID.ValueString()
 	}
+	if !config.AuthorityHost.IsNull() {
+		authorityHost = config.AuthorityHost.ValueString()
+	}
+	if !config.ClientCertificatePath.IsNull() {
+		clientCertPath = config.ClientCertificatePath.ValueString()
+	}
+	if !config.ClientCertificatePassword.IsNull() {
+		clientCertPassword = config.ClientCertificatePassword.ValueString()
+	}
+	if !config.OIDCToken.IsNull() {
+		oidcToken = config.OIDCToken.ValueString()
+	}
+	if !config.OIDCRequestURL.IsNull() {
+		oidcRequestURL = config.OIDCRequestURL.ValueString()
+	}
+	if !config.OIDCRequestToken.IsNull() {
+		oidcRequestToken = config.OIDCRequestToken.ValueString()
+	}
+
+	if authMode == "" {
+		authMode = string(client.AuthModeClientSecret)
+	}
 
 	// Validate required configuration
 	if serverURL == "" {
@@ -108,26 +352,34 @@ func (p *AethervProvider) Configure(ctx context.Context, req provider.ConfigureR
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_id"),
 			"Missing Aether-V Client ID",
-			"The provider cannot create the Aether-V API client as there is a missing or empty value for the OAuth2 client ID. "+
+			"The provider cannot create the Aether-V API client as there is a missing or empty value for the Azure AD client ID. "+
 				"Set the client_id value in the configuration or use the AETHERV_CLIENT_ID environment variable.",
 		)
 	}
 
-	if clientSecret == "" {
+	if tenantID == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tenant_id"),
+			"Missing Aether-V Tenant ID",
+			"The provider cannot create the Aether-V API client as there is a missing or empty value for the tenant ID. "+
+				"Set the tenant_id value in the configuration or use the AETHERV_TENANT_ID environment variable.",
+		)
+	}
+
+	if client.AuthMode(authMode) == client.AuthModeClientSecret && clientSecret == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_secret"),
 			"Missing Aether-V Client Secret",
-			"The provider cannot create the Aether-V API client as there is a missing or empty value for the OAuth2 client secret. "+
-				"Set the client_secret value in the configuration or use the AETHERV_CLIENT_SECRET environment variable.",
+			"auth_mode = \"client_secret\" requires a client secret. "+
+				"Set the client_secret value in the configuration, use the AETHERV_CLIENT_SECRET environment variable, or switch to a different auth_mode.",
 		)
 	}
 
-	if tenantID == "" {
+	if client.AuthMode(authMode) == client.AuthModeClientCertificate && clientCertPath == "" {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("tenant_id"),
-			"Missing Aether-V Tenant ID",
-			"The provider cannot create the Aether-V API client as there is a missing or empty value for the tenant ID. "+
-				"Set the tenant_id value in the configuration or use the AETHERV_TENANT_ID environment variable.",
+			path.Root("client_certificate_path"),
+			"Missing Aether-V Client Certificate",
+			"auth_mode = \"client_certificate\" requires client_certificate_path to be set.",
 		)
 	}
 
@@ -136,7 +388,18 @@ func (p *AethervProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 
 	// Create the API client
-	apiClient, err := client.NewClient(ctx, serverURL, clientID, clientSecret, tenantID)
+	apiClient, err := client.NewClient(ctx, serverURL, client.AuthConfig{
+		Mode:                      client.AuthMode(authMode),
+		TenantID:                  tenantID,
+		ClientID:                  clientID,
+		AuthorityHost:             authorityHost,
+		ClientSecret:              clientSecret,
+		ClientCertificatePath:     clientCertPath,
+		ClientCertificatePassword: clientCertPassword,
+		OIDCToken:                 oidcToken,
+		OIDCRequestURL:            oidcRequestURL,
+		OIDCRequestToken:          oidcRequestToken,
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Aether-V API Client",
@@ -147,14 +410,56 @@ func (p *AethervProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// Make the client available during DataSource and Resource type Configure methods.
-	resp.DataSourceData = apiClient
-	resp.ResourceData = apiClient
+	providerData := &ProviderData{Client: apiClient}
+
+	if config.Vault != nil {
+		secretsClient, err := newVaultClient(ctx, config.Vault)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Vault Client",
+				"An unexpected error occurred when creating the vault client for guest-customization secret resolution. "+
+					"Vault Client Error: "+err.Error(),
+			)
+			return
+		}
+		providerData.Secrets = secretsClient
+	}
+
+	if config.Ipam != nil {
+		ipamClient, err := newIPAMClient(config.Ipam)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create IPAM Client",
+				"An unexpected error occurred when creating the ipam client for ipconfig address allocation. "+
+					"IPAM Client Error: "+err.Error(),
+			)
+			return
+		}
+		providerData.IPAM = ipamClient
+	}
+
+	if config.Batching != nil && config.Batching.Enabled.ValueBool() {
+		opts := client.BatchOptions{}
+		if !config.Batching.MaxBatchSize.IsNull() {
+			opts.MaxBatchSize = int(config.Batching.MaxBatchSize.ValueInt64())
+		}
+		if !config.Batching.MaxDelayMs.IsNull() {
+			opts.MaxDelay = time.Duration(config.Batching.MaxDelayMs.ValueInt64()) * time.Millisecond
+		}
+		providerData.Batch = client.NewBatchClient(apiClient, opts)
+	}
+
+	// Make the client (and batch coalescer, if enabled) available during
+	// DataSource and Resource type Configure methods.
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *AethervProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewVirtualMachineResource,
+		NewVirtualSwitchResource,
+		NewVirtualMachineCheckpointResource,
 	}
 }
 
@@ -162,7 +467,10 @@ func (p *AethervProvider) DataSources(ctx context.Context) []func() datasource.D
 	return []func() datasource.DataSource{
 		NewHostsDataSource,
 		NewNetworksDataSource,
+		NewNetworkDataSource,
 		NewImagesDataSource,
+		NewTagDataSource,
+		NewVirtualMachineCheckpointsDataSource,
 	}
 }
 
@@ -174,3 +482,59 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// newVaultClient builds a secrets.Client from the vault block, defaulting
+// address/namespace/token from Vault's own conventional environment
+// variables the way the rest of Configure defaults from AETHERV_* ones.
+func newVaultClient(ctx context.Context, v *VaultModel) (*secrets.Client, error) {
+	address := firstNonEmpty(v.Address.ValueString(), os.Getenv("VAULT_ADDR"))
+	if address == "" {
+		return nil, fmt.Errorf("vault.address is required (or set VAULT_ADDR)")
+	}
+
+	authMode := v.AuthMode.ValueString()
+	if authMode == "" {
+		authMode = string(secrets.AuthModeToken)
+	}
+
+	cfg := secrets.Config{
+		Address:             address,
+		Namespace:           firstNonEmpty(v.Namespace.ValueString(), os.Getenv("VAULT_NAMESPACE")),
+		Mode:                secrets.AuthMode(authMode),
+		Token:               firstNonEmpty(v.Token.ValueString(), os.Getenv("VAULT_TOKEN")),
+		RoleID:              v.RoleID.ValueString(),
+		SecretID:            v.SecretID.ValueString(),
+		KubernetesRole:      v.KubernetesRole.ValueString(),
+		KubernetesMountPath: v.KubernetesMountPath.ValueString(),
+		KubernetesTokenPath: v.KubernetesTokenPath.ValueString(),
+	}
+
+	return secrets.NewClient(ctx, cfg)
+}
+
+// newIPAMClient builds an ipam.Client from the ipam block.
+func newIPAMClient(v *IPAMModel) (*ipam.Client, error) {
+	backend := v.Backend.ValueString()
+	if backend == "" {
+		backend = string(ipam.BackendPHPIPAM)
+	}
+
+	cfg := ipam.Config{
+		Backend: ipam.BackendKind(backend),
+		Address: v.Address.ValueString(),
+		Token:   v.Token.ValueString(),
+		AppID:   v.AppID.ValueString(),
+	}
+
+	return ipam.NewClient(cfg)
+}
+
+// firstNonEmpty returns the first non-empty value, or "" if all are empty.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
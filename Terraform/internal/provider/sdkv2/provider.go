@@ -0,0 +1,280 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdkv2 hosts the terraform-plugin-sdk/v2 half of the Aether-V
+// provider. It is served alongside the terraform-plugin-framework provider
+// in internal/provider via the mux server in internal/provider/mux.go.
+//
+// Resources land here instead of in internal/provider when they need
+// SDKv2-only plan-time machinery (CustomizeDiff, ValidateDiagFunc, an
+// Importer with state upgraders) that the framework does not yet expose.
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// New returns a function that creates a new instance of the SDKv2 provider.
+// version is set to the provider version on release, "dev" when the
+// provider is built and ran locally, and "test" when running acceptance
+// testing.
+func New(version string) func() *schema.Provider {
+	return func() *schema.Provider {
+		p := &schema.Provider{
+			// Schema must stay in lockstep with the framework provider's
+			// Schema() in internal/provider/provider.go: Terraform core
+			// only sees one provider config block for "aetherv", so both
+			// halves of the muxed server need to agree on its shape.
+			Schema: map[string]*schema.Schema{
+				"server_url": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_SERVER_URL", nil),
+					Description: "The URL of the Aether-V server. Can also be set via the AETHERV_SERVER_URL environment variable.",
+				},
+				"auth_mode": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_AUTH_MODE", string(client.AuthModeClientSecret)),
+					Description: "How to authenticate to Azure AD: \"client_secret\" (default), \"client_certificate\", \"workload_identity\", \"managed_identity\", \"azure_cli\", or \"oidc\".",
+				},
+				"client_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_CLIENT_ID", nil),
+					Description: "The Azure AD application (client) ID. Can also be set via the AETHERV_CLIENT_ID environment variable.",
+				},
+				"client_secret": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_CLIENT_SECRET", nil),
+					Description: "The OAuth2 client secret, used when auth_mode = \"client_secret\". Can also be set via the AETHERV_CLIENT_SECRET environment variable.",
+				},
+				"tenant_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_TENANT_ID", nil),
+					Description: "The Azure AD tenant ID. Can also be set via the AETHERV_TENANT_ID environment variable.",
+				},
+				"authority_host": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_AUTHORITY_HOST", nil),
+					Description: "Overrides the Azure AD authority host for sovereign clouds.",
+				},
+				"client_certificate_path": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_CLIENT_CERTIFICATE_PATH", nil),
+					Description: "Path to a PEM file containing the client certificate and private key, used when auth_mode = \"client_certificate\".",
+				},
+				"client_certificate_password": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_CLIENT_CERTIFICATE_PASSWORD", nil),
+					Description: "Password for the client certificate's private key. Accepted but not used: the private key in client_certificate_path must be unencrypted.",
+				},
+				"oidc_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_OIDC_TOKEN", nil),
+					Description: "A pre-fetched OIDC token to exchange for an access token, used when auth_mode = \"oidc\".",
+				},
+				"oidc_request_url": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_OIDC_REQUEST_URL", nil),
+					Description: "URL to fetch an OIDC token from when oidc_token is not set, e.g. GitHub Actions' ACTIONS_ID_TOKEN_REQUEST_URL.",
+				},
+				"oidc_request_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("AETHERV_OIDC_REQUEST_TOKEN", nil),
+					Description: "Bearer token used to authenticate to oidc_request_url, e.g. GitHub Actions' ACTIONS_ID_TOKEN_REQUEST_TOKEN.",
+				},
+				// batching/vault/ipam are declared here only for schema
+				// parity with the framework provider's Schema() - tf6muxserver
+				// requires every muxed server to advertise the same provider
+				// config schema. None of it is read in configure() below:
+				// ResourcesMap is empty, so this half has nothing that would
+				// consume a BatchClient, secrets.Client, or ipam.Client yet.
+				"batching": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether to coalesce concurrent VM creation requests targeting the same host or cluster. Defaults to false.",
+							},
+							"max_batch_size": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "Flush a pending batch once it reaches this many requests. Defaults to 25.",
+							},
+							"max_delay_ms": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "Flush a pending batch this many milliseconds after its first request arrived, even if max_batch_size hasn't been reached. Defaults to 100.",
+							},
+						},
+					},
+				},
+				"vault": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"address": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The Vault server's base URL, e.g. \"https://vault.example.com:8200\". Required if this block is present. Can also be set via the VAULT_ADDR environment variable.",
+							},
+							"namespace": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "A Vault Enterprise namespace to operate in. Leave unset for Vault Community Edition or the root namespace. Can also be set via the VAULT_NAMESPACE environment variable.",
+							},
+							"auth_mode": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "How to authenticate to Vault: \"token\" (default), \"approle\", or \"kubernetes\".",
+							},
+							"token": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "The Vault token to use, when auth_mode = \"token\". Can also be set via the VAULT_TOKEN environment variable.",
+							},
+							"role_id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The AppRole role ID, used when auth_mode = \"approle\".",
+							},
+							"secret_id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "The AppRole secret ID, used when auth_mode = \"approle\".",
+							},
+							"kubernetes_role": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The Vault role to authenticate as, used when auth_mode = \"kubernetes\".",
+							},
+							"kubernetes_mount_path": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Overrides the kubernetes auth method's mount path. Defaults to \"kubernetes\".",
+							},
+							"kubernetes_token_path": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Overrides the path the pod's projected service account token is read from. Defaults to the standard Kubernetes-injected path.",
+							},
+						},
+					},
+				},
+				"ipam": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"backend": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Which IPAM system to talk to: \"phpipam\" (default) or \"netbox\".",
+							},
+							"address": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The IPAM server's base URL, e.g. \"https://ipam.example.com\". Required if this block is present.",
+							},
+							"token": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "The API token used to authenticate to the IPAM backend.",
+							},
+							"app_id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The phpIPAM API application ID. Required when backend = \"phpipam\".",
+							},
+						},
+					},
+				},
+			},
+			// ResourcesMap holds SDKv2-based resources that need plan-time
+			// machinery the framework doesn't support yet (dependent-attribute
+			// diffs, computed defaults derived from other config values).
+			// Empty for now; resources are added here as they're ported or
+			// introduced with those requirements.
+			ResourcesMap:   map[string]*schema.Resource{},
+			DataSourcesMap: map[string]*schema.Resource{},
+		}
+
+		p.ConfigureContextFunc = configure(version, p)
+
+		return p
+	}
+}
+
+// configure builds the provider's ConfigureContextFunc, constructing the
+// shared *client.Client the same way the framework provider does so that
+// both halves of the muxed server talk to the same server with the same
+// credentials.
+func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		serverURL := d.Get("server_url").(string)
+		authMode := d.Get("auth_mode").(string)
+		clientID := d.Get("client_id").(string)
+		tenantID := d.Get("tenant_id").(string)
+
+		if serverURL == "" || clientID == "" || tenantID == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Missing Aether-V Provider Configuration",
+				Detail:   "server_url, client_id, and tenant_id must all be set, either in the provider block or via the AETHERV_* environment variables.",
+			})
+			return nil, diags
+		}
+
+		apiClient, err := client.NewClient(ctx, serverURL, client.AuthConfig{
+			Mode:                      client.AuthMode(authMode),
+			TenantID:                  tenantID,
+			ClientID:                  clientID,
+			AuthorityHost:             d.Get("authority_host").(string),
+			ClientSecret:              d.Get("client_secret").(string),
+			ClientCertificatePath:     d.Get("client_certificate_path").(string),
+			ClientCertificatePassword: d.Get("client_certificate_password").(string),
+			OIDCToken:                 d.Get("oidc_token").(string),
+			OIDCRequestURL:            d.Get("oidc_request_url").(string),
+			OIDCRequestToken:          d.Get("oidc_request_token").(string),
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to Create Aether-V API Client",
+				Detail:   err.Error(),
+			})
+			return nil, diags
+		}
+
+		return apiClient, diags
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagDataSource{}
+
+// NewTagDataSource creates a new TagDataSource.
+func NewTagDataSource() datasource.DataSource {
+	return &TagDataSource{}
+}
+
+// TagDataSource looks up a single tag's value on a resource by key, so
+// modules can branch on a tag without pulling in the whole resource.
+type TagDataSource struct {
+	client *client.Client
+}
+
+// TagDataSourceModel describes the data source data model.
+type TagDataSourceModel struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	Key          types.String `tfsdk:"key"`
+	Value        types.String `tfsdk:"value"`
+}
+
+func (d *TagDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+func (d *TagDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the value of a single tag on a resource, using the same generic tag API that `internal/provider/tagging` reconciles against. Errors if the resource has no tag with the given key.",
+
+		Attributes: map[string]schema.Attribute{
+			"resource_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type segment the resource was tagged under, e.g. `vms`.",
+			},
+			"resource_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique ID of the tagged resource.",
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The tag key to look up.",
+			},
+			"value": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The tag's value.",
+			},
+		},
+	}
+}
+
+func (d *TagDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *TagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TagDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceType := data.ResourceType.ValueString()
+	resourceID := data.ResourceID.ValueString()
+	key := data.Key.ValueString()
+
+	tflog.Debug(ctx, "Reading tag data source", map[string]interface{}{
+		"resource_type": resourceType,
+		"resource_id":   resourceID,
+		"key":           key,
+	})
+
+	tags, err := d.client.ListResourceTags(ctx, resourceType, resourceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tag",
+			fmt.Sprintf("Could not list tags for %s %s: %s", resourceType, resourceID, err),
+		)
+		return
+	}
+
+	value, ok := tags[key]
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Tag Not Found",
+			fmt.Sprintf("%s %s has no tag with key %q.", resourceType, resourceID, key),
+		)
+		return
+	}
+
+	data.Value = types.StringValue(value)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tagging factors the tag-diff/apply logic shared by every
+// taggable resource (virtual machines today; disks, NICs, networks, and
+// images are expected to reuse it) into one place, so each resource's
+// Create/Update only has to supply its resource type/ID and the planned
+// tag map.
+package tagging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Client is the subset of *client.Client that Apply depends on, defined
+// here at the point of use so callers (and tests) can fake it without
+// widening client.Client into an interface.
+type Client interface {
+	UpdateResourceTags(ctx context.Context, resourceType, resourceID string, set map[string]string, unset []string) (map[string]string, error)
+}
+
+// Diff is the set of changes needed to reconcile a resource's tags from
+// prior to planned.
+type Diff struct {
+	// Set contains keys that are new in planned or whose value changed.
+	Set map[string]string
+	// Unset contains keys present in prior but absent from planned.
+	Unset []string
+}
+
+// IsEmpty reports whether the diff requires no API call.
+func (d Diff) IsEmpty() bool {
+	return len(d.Set) == 0 && len(d.Unset) == 0
+}
+
+// ComputeDiff computes the add/update/remove sets between a resource's
+// planned and prior tags.
+func ComputeDiff(planned, prior map[string]string) Diff {
+	diff := Diff{Set: make(map[string]string)}
+
+	for k, v := range planned {
+		if priorV, ok := prior[k]; !ok || priorV != v {
+			diff.Set[k] = v
+		}
+	}
+
+	for k := range prior {
+		if _, ok := planned[k]; !ok {
+			diff.Unset = append(diff.Unset, k)
+		}
+	}
+
+	return diff
+}
+
+// Apply reconciles resourceID's tags from prior to planned by computing
+// the diff and, if non-empty, calling c.UpdateResourceTags. On failure it
+// surfaces a resource-level diagnostic and returns prior unchanged rather
+// than guessing at what partially applied, so Terraform state never
+// claims tags the server doesn't actually have.
+func Apply(ctx context.Context, c Client, resourceType, resourceID string, planned, prior map[string]string, diags *diag.Diagnostics) map[string]string {
+	diff := ComputeDiff(planned, prior)
+	if diff.IsEmpty() {
+		return prior
+	}
+
+	result, err := c.UpdateResourceTags(ctx, resourceType, resourceID, diff.Set, diff.Unset)
+	if err != nil {
+		diags.AddWarning(
+			"Tag Reconciliation Incomplete",
+			fmt.Sprintf("Could not reconcile tags on %s %s: %s. Tags were left unchanged; this will be retried on the next apply.", resourceType, resourceID, err),
+		)
+		return prior
+	}
+
+	return result
+}
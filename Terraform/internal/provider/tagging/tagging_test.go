@@ -0,0 +1,86 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package tagging
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestComputeDiff(t *testing.T) {
+	tests := []struct {
+		name      string
+		planned   map[string]string
+		prior     map[string]string
+		wantSet   map[string]string
+		wantUnset []string
+	}{
+		{
+			name:      "add only",
+			planned:   map[string]string{"env": "prod"},
+			prior:     map[string]string{},
+			wantSet:   map[string]string{"env": "prod"},
+			wantUnset: nil,
+		},
+		{
+			name:      "remove only",
+			planned:   map[string]string{},
+			prior:     map[string]string{"env": "prod"},
+			wantSet:   map[string]string{},
+			wantUnset: []string{"env"},
+		},
+		{
+			name:      "update changed value",
+			planned:   map[string]string{"env": "staging"},
+			prior:     map[string]string{"env": "prod"},
+			wantSet:   map[string]string{"env": "staging"},
+			wantUnset: nil,
+		},
+		{
+			name:      "unchanged value is not in the diff",
+			planned:   map[string]string{"env": "prod"},
+			prior:     map[string]string{"env": "prod"},
+			wantSet:   map[string]string{},
+			wantUnset: nil,
+		},
+		{
+			name:      "no tags",
+			planned:   map[string]string{},
+			prior:     map[string]string{},
+			wantSet:   map[string]string{},
+			wantUnset: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := ComputeDiff(tt.planned, tt.prior)
+
+			if !reflect.DeepEqual(diff.Set, tt.wantSet) {
+				t.Errorf("Set = %v, want %v", diff.Set, tt.wantSet)
+			}
+
+			gotUnset := append([]string{}, diff.Unset...)
+			sort.Strings(gotUnset)
+			wantUnset := append([]string{}, tt.wantUnset...)
+			sort.Strings(wantUnset)
+			if !reflect.DeepEqual(gotUnset, wantUnset) {
+				t.Errorf("Unset = %v, want %v", gotUnset, wantUnset)
+			}
+		})
+	}
+}
+
+func TestDiffIsEmpty(t *testing.T) {
+	if !(Diff{}).IsEmpty() {
+		t.Error("zero-value Diff should be empty")
+	}
+	if (Diff{Set: map[string]string{"k": "v"}}).IsEmpty() {
+		t.Error("Diff with a Set entry should not be empty")
+	}
+	if (Diff{Unset: []string{"k"}}).IsEmpty() {
+		t.Error("Diff with an Unset entry should not be empty")
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &VirtualSwitchResource{}
+	_ resource.ResourceWithImportState = &VirtualSwitchResource{}
+)
+
+// NewVirtualSwitchResource creates a new VirtualSwitchResource.
+func NewVirtualSwitchResource() resource.Resource {
+	return &VirtualSwitchResource{}
+}
+
+// VirtualSwitchResource manages a virtual switch on a target host, the
+// read-write counterpart to the read-only NetworksDataSource/NetworkDataSource.
+type VirtualSwitchResource struct {
+	client *client.Client
+}
+
+// VirtualSwitchResourceModel describes the resource data model.
+type VirtualSwitchResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	SwitchType            types.String `tfsdk:"switch_type"`
+	Host                  types.String `tfsdk:"host"`
+	NetAdapterName        types.String `tfsdk:"net_adapter_name"`
+	AllowManagementOS     types.Bool   `tfsdk:"allow_management_os"`
+	EnableIOV             types.Bool   `tfsdk:"enable_iov"`
+	EnableEmbeddedTeaming types.Bool   `tfsdk:"enable_embedded_teaming"`
+}
+
+func (r *VirtualSwitchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_switch"
+}
+
+func (r *VirtualSwitchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an Aether-V virtual switch.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier (switch ID) of the virtual switch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The virtual switch name.",
+			},
+			"switch_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The switch type. Valid values: 'External', 'Internal', 'Private'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("External", "Internal", "Private"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The host this switch is created on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"net_adapter_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The physical network adapter(s) to bind, for an External switch.",
+			},
+			"allow_management_os": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the management OS shares this switch.",
+			},
+			"enable_iov": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to enable single-root I/O virtualization (SR-IOV) on this switch.",
+			},
+			"enable_embedded_teaming": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to enable embedded NIC teaming across net_adapter_name's adapters.",
+			},
+		},
+	}
+}
+
+func (r *VirtualSwitchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *VirtualSwitchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtualSwitchResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating virtual switch", map[string]interface{}{
+		"name": data.Name.ValueString(),
+		"host": data.Host.ValueString(),
+	})
+
+	n, err := r.client.CreateNetwork(ctx, data.toSpec())
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Create Virtual Switch", err)
+		return
+	}
+
+	data.applyNetwork(n)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtualSwitchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtualSwitchResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading virtual switch", map[string]interface{}{
+		"id":   data.ID.ValueString(),
+		"host": data.Host.ValueString(),
+		"name": data.Name.ValueString(),
+	})
+
+	var n *client.Network
+	var err error
+	if id := data.ID.ValueString(); id != "" {
+		n, err = r.client.GetNetwork(ctx, id)
+	} else {
+		// Freshly imported by host/name; resolve the switch ID before we can
+		// refresh the rest of the attributes.
+		n, err = findNetworkByHostAndName(ctx, r.client, data.Host.ValueString(), data.Name.ValueString())
+	}
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Read Virtual Switch", err)
+		return
+	}
+
+	data.applyNetwork(n)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtualSwitchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtualSwitchResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state VirtualSwitchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating virtual switch", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	n, err := r.client.UpdateNetwork(ctx, state.ID.ValueString(), data.toSpec())
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Update Virtual Switch", err)
+		return
+	}
+
+	data.applyNetwork(n)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtualSwitchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtualSwitchResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting virtual switch", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	if err := r.client.DeleteNetwork(ctx, data.ID.ValueString()); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Delete Virtual Switch", err)
+		return
+	}
+}
+
+// ImportState accepts "host/name" so a switch can be imported without
+// already knowing its server-assigned switch ID; Read resolves the ID on
+// the next refresh.
+func (r *VirtualSwitchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	host, name, ok := strings.Cut(req.ID, "/")
+	if !ok || host == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier of the form host/name, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), host)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// toSpec converts the resource model into the API request body.
+func (data *VirtualSwitchResourceModel) toSpec() *client.VirtualSwitchSpec {
+	return &client.VirtualSwitchSpec{
+		Name:                  data.Name.ValueString(),
+		SwitchType:            data.SwitchType.ValueString(),
+		Host:                  data.Host.ValueString(),
+		NetAdapterName:        data.NetAdapterName.ValueString(),
+		AllowManagementOS:     data.AllowManagementOS.ValueBoolPointer(),
+		EnableIOV:             data.EnableIOV.ValueBoolPointer(),
+		EnableEmbeddedTeaming: data.EnableEmbeddedTeaming.ValueBoolPointer(),
+	}
+}
+
+// applyNetwork refreshes data from n, the same API shape NetworksDataSource
+// and NetworkDataSource convert via toVirtualSwitchModel.
+func (data *VirtualSwitchResourceModel) applyNetwork(n *client.Network) {
+	data.ID = types.StringValue(n.SwitchID)
+	data.Name = types.StringValue(n.Name)
+	data.SwitchType = types.StringValue(n.SwitchType)
+	data.Host = types.StringValue(n.Host)
+	data.NetAdapterName = types.StringValue(n.NetAdapterName)
+	data.AllowManagementOS = types.BoolValue(n.AllowManagementOS != nil && *n.AllowManagementOS)
+	data.EnableIOV = types.BoolValue(n.EnableIOV != nil && *n.EnableIOV)
+	data.EnableEmbeddedTeaming = types.BoolValue(n.EnableEmbeddedTeaming != nil && *n.EnableEmbeddedTeaming)
+}
+
+// findNetworkByHostAndName resolves a switch by its exact host and name,
+// erroring if zero or more than one match.
+func findNetworkByHostAndName(ctx context.Context, c *client.Client, host, name string) (*client.Network, error) {
+	networks, err := c.ListNetworks(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []client.Network
+	for _, n := range networks {
+		if n.Name == name {
+			matches = append(matches, n)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no virtual switch named %q found on host %q", name, host)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d virtual switches named %q on host %q", len(matches), name, host)
+	}
+}
+
+// addAPIError wraps err as a Terraform diagnostic.
+func (r *VirtualSwitchResource) addAPIError(diags *diag.Diagnostics, summary string, err error) {
+	diags.AddError(
+		summary,
+		fmt.Sprintf("API error: %s", err.Error()),
+	)
+}
@@ -0,0 +1,290 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &VirtualMachineCheckpointResource{}
+	_ resource.ResourceWithImportState = &VirtualMachineCheckpointResource{}
+)
+
+// NewVirtualMachineCheckpointResource creates a new VirtualMachineCheckpointResource.
+func NewVirtualMachineCheckpointResource() resource.Resource {
+	return &VirtualMachineCheckpointResource{}
+}
+
+// VirtualMachineCheckpointResource manages a single Hyper-V checkpoint of an
+// aetherv_virtual_machine, using the create/apply/delete step sequence the
+// underlying API exposes (see client.CreateCheckpoint/ApplyCheckpoint/DeleteCheckpoint).
+type VirtualMachineCheckpointResource struct {
+	client *client.Client
+}
+
+// VirtualMachineCheckpointResourceModel describes the resource data model.
+type VirtualMachineCheckpointResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	VMID               types.String `tfsdk:"vm_id"`
+	Name               types.String `tfsdk:"name"`
+	CheckpointType     types.String `tfsdk:"checkpoint_type"`
+	ParentCheckpointID types.String `tfsdk:"parent_checkpoint_id"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+
+	// Revert is a trigger, not declarative state: setting it to true (from
+	// false, or from unset) reverts vm_id to this checkpoint on the next
+	// apply. It is never reset automatically, so toggling it back to false
+	// and true again re-triggers the revert.
+	Revert types.Bool `tfsdk:"revert"`
+}
+
+func (r *VirtualMachineCheckpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_machine_checkpoint"
+}
+
+func (r *VirtualMachineCheckpointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single Hyper-V checkpoint of an aetherv_virtual_machine. Destroying a checkpoint resource deletes the checkpoint; it does not revert the VM. Deleting the parent aetherv_virtual_machine while checkpoints still exist fails unless the VM's force_delete_checkpoints is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the checkpoint.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vm_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the aetherv_virtual_machine this checkpoint belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A name for the checkpoint. The server generates one if omitted. Renaming a checkpoint isn't supported, so changing this forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"checkpoint_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The checkpoint type: 'production', 'production_fallback', or 'standard'. Defaults to the owning VM's checkpoint_type.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("production", "production_fallback", "standard"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_checkpoint_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the checkpoint this one was taken from, if any.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the checkpoint was created, in RFC 3339 format.",
+			},
+			"revert": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set to true (toggling from false, or from unset) to revert vm_id to this checkpoint's state on the next apply. This is a one-time action, not declarative state: it is never cleared automatically.",
+			},
+		},
+	}
+}
+
+func (r *VirtualMachineCheckpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *VirtualMachineCheckpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtualMachineCheckpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating virtual machine checkpoint", map[string]interface{}{
+		"vm_id": data.VMID.ValueString(),
+		"name":  data.Name.ValueString(),
+	})
+
+	jobResult, err := r.client.CreateCheckpoint(ctx, &client.CheckpointSpec{
+		VMID:           data.VMID.ValueString(),
+		Name:           data.Name.ValueString(),
+		CheckpointType: data.CheckpointType.ValueString(),
+	})
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Create Virtual Machine Checkpoint", err)
+		return
+	}
+
+	if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Virtual Machine Checkpoint Creation Failed", err)
+		return
+	}
+
+	// jobResult.VMID carries the ID of whatever resource the job created,
+	// not necessarily a VM; CreateCDROM's callers rely on the same reuse.
+	checkpoint, err := r.client.GetCheckpoint(ctx, jobResult.VMID)
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Read Virtual Machine Checkpoint", err)
+		return
+	}
+
+	data.applyCheckpoint(checkpoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtualMachineCheckpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtualMachineCheckpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading virtual machine checkpoint", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	checkpoint, err := r.client.GetCheckpoint(ctx, data.ID.ValueString())
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Read Virtual Machine Checkpoint", err)
+		return
+	}
+
+	data.applyCheckpoint(checkpoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only ever handles a revert trigger flipping to true; every other
+// attribute forces replacement (see Schema), so there is nothing else to
+// reconcile here.
+func (r *VirtualMachineCheckpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtualMachineCheckpointResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state VirtualMachineCheckpointResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Revert.ValueBool() && !state.Revert.ValueBool() {
+		tflog.Debug(ctx, "Reverting virtual machine to checkpoint", map[string]interface{}{
+			"id":    data.ID.ValueString(),
+			"vm_id": data.VMID.ValueString(),
+		})
+
+		jobResult, err := r.client.ApplyCheckpoint(ctx, data.ID.ValueString())
+		if err != nil {
+			r.addAPIError(&resp.Diagnostics, "Unable to Revert Virtual Machine Checkpoint", err)
+			return
+		}
+
+		if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+			r.addAPIError(&resp.Diagnostics, "Virtual Machine Checkpoint Revert Failed", err)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtualMachineCheckpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtualMachineCheckpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting virtual machine checkpoint", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	jobResult, err := r.client.DeleteCheckpoint(ctx, data.ID.ValueString())
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Delete Virtual Machine Checkpoint", err)
+		return
+	}
+
+	if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Virtual Machine Checkpoint Deletion Failed", err)
+		return
+	}
+}
+
+// ImportState accepts "vm_id/checkpoint_id".
+func (r *VirtualMachineCheckpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vmID, checkpointID, ok := strings.Cut(req.ID, "/")
+	if !ok || vmID == "" || checkpointID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier of the form vm_id/checkpoint_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), vmID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), checkpointID)...)
+}
+
+// applyCheckpoint refreshes data from cp.
+func (data *VirtualMachineCheckpointResourceModel) applyCheckpoint(cp *client.Checkpoint) {
+	data.ID = types.StringValue(cp.ID)
+	data.VMID = types.StringValue(cp.VMID)
+	data.Name = types.StringValue(cp.Name)
+	data.CheckpointType = types.StringValue(cp.CheckpointType)
+	data.ParentCheckpointID = types.StringValue(cp.ParentCheckpointID)
+	data.CreatedAt = types.StringValue(cp.CreatedAt)
+}
+
+// addAPIError wraps err as a Terraform diagnostic.
+func (r *VirtualMachineCheckpointResource) addAPIError(diags *diag.Diagnostics, summary string, err error) {
+	diags.AddError(
+		summary,
+		fmt.Sprintf("API error: %s", err.Error()),
+	)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VirtualMachineCheckpointsDataSource{}
+
+// NewVirtualMachineCheckpointsDataSource creates a new VirtualMachineCheckpointsDataSource.
+func NewVirtualMachineCheckpointsDataSource() datasource.DataSource {
+	return &VirtualMachineCheckpointsDataSource{}
+}
+
+// VirtualMachineCheckpointsDataSource lists the checkpoints that exist for
+// a virtual machine, the read-only counterpart to VirtualMachineCheckpointResource.
+type VirtualMachineCheckpointsDataSource struct {
+	client *client.Client
+}
+
+// VirtualMachineCheckpointsDataSourceModel describes the data source data model.
+type VirtualMachineCheckpointsDataSourceModel struct {
+	VMID        types.String      `tfsdk:"vm_id"`
+	Checkpoints []CheckpointModel `tfsdk:"checkpoints"`
+}
+
+// CheckpointModel describes a single checkpoint.
+type CheckpointModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	CheckpointType     types.String `tfsdk:"checkpoint_type"`
+	ParentCheckpointID types.String `tfsdk:"parent_checkpoint_id"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+func (d *VirtualMachineCheckpointsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_machine_checkpoints"
+}
+
+func (d *VirtualMachineCheckpointsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the checkpoints that exist for a virtual machine.",
+
+		Attributes: map[string]schema.Attribute{
+			"vm_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the virtual machine to list checkpoints for.",
+			},
+			"checkpoints": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The virtual machine's checkpoints.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The checkpoint's unique ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The checkpoint's name.",
+						},
+						"checkpoint_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The checkpoint type ('production', 'production_fallback', or 'standard').",
+						},
+						"parent_checkpoint_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the checkpoint this one was taken from, if any.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the checkpoint was created, in RFC 3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VirtualMachineCheckpointsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *VirtualMachineCheckpointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtualMachineCheckpointsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading virtual machine checkpoints data source", map[string]interface{}{
+		"vm_id": data.VMID.ValueString(),
+	})
+
+	checkpoints, err := d.client.ListCheckpoints(ctx, data.VMID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Virtual Machine Checkpoints",
+			fmt.Sprintf("Could not list checkpoints for %s: %s", data.VMID.ValueString(), err),
+		)
+		return
+	}
+
+	data.Checkpoints = make([]CheckpointModel, 0, len(checkpoints))
+	for _, cp := range checkpoints {
+		data.Checkpoints = append(data.Checkpoints, CheckpointModel{
+			ID:                 types.StringValue(cp.ID),
+			Name:               types.StringValue(cp.Name),
+			CheckpointType:     types.StringValue(cp.CheckpointType),
+			ParentCheckpointID: types.StringValue(cp.ParentCheckpointID),
+			CreatedAt:          types.StringValue(cp.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -6,8 +6,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/aetherv/aether-v/terraform/internal/ipam"
+	"github.com/aetherv/aether-v/terraform/internal/secrets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -16,28 +20,73 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/aetherv/aether-v/terraform/internal/provider/tagging"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &VirtualMachineResource{}
-	_ resource.ResourceWithImportState = &VirtualMachineResource{}
+	_ resource.Resource                   = &VirtualMachineResource{}
+	_ resource.ResourceWithImportState    = &VirtualMachineResource{}
+	_ resource.ResourceWithValidateConfig = &VirtualMachineResource{}
+	_ resource.ResourceWithUpgradeState   = &VirtualMachineResource{}
 )
 
+// vmTagResourceType is the resourceType segment this resource passes to the
+// generic tag API (client.ListResourceTags/UpdateResourceTags).
+const vmTagResourceType = "vms"
+
 // NewVirtualMachineResource creates a new VirtualMachineResource.
 func NewVirtualMachineResource() resource.Resource {
 	return &VirtualMachineResource{}
 }
 
+// vmClient is the subset of *client.Client that VirtualMachineResource
+// depends on. Defined here, at the point of use, so
+// internal/testing/testclient can fake it for unit tests without widening
+// client.Client into an interface everywhere else it's consumed.
+type vmClient interface {
+	CreateVM(ctx context.Context, spec *client.VmSpec) (*client.JobResult, error)
+	DeleteVM(ctx context.Context, vmID string) (*client.JobResult, error)
+	UpdateVM(ctx context.Context, vmID string, spec *client.VMUpdateSpec) (*client.JobResult, error)
+	CreateDisk(ctx context.Context, spec *client.DiskSpec) (*client.JobResult, error)
+	ResizeDisk(ctx context.Context, diskID string, newSizeGB int) (*client.JobResult, error)
+	CreateNIC(ctx context.Context, spec *client.NicSpec) (*client.JobResult, error)
+	CreateCDROM(ctx context.Context, spec *client.CDROMSpec) (*client.JobResult, error)
+	CreateCloudInitISO(ctx context.Context, spec *client.CloudInitSpec) (*client.JobResult, error)
+	GetCloudInitISO(ctx context.Context, isoID string) (*client.CloudInitISO, error)
+	InitializeVM(ctx context.Context, vmID string, req *client.VMInitializationRequest) (*client.JobResult, error)
+	ListCheckpoints(ctx context.Context, vmID string) ([]client.Checkpoint, error)
+	DeleteCheckpoint(ctx context.Context, checkpointID string) (*client.JobResult, error)
+	ListResourceTags(ctx context.Context, resourceType, resourceID string) (map[string]string, error)
+	UpdateResourceTags(ctx context.Context, resourceType, resourceID string, set map[string]string, unset []string) (map[string]string, error)
+	GetJob(ctx context.Context, jobID string) (*client.Job, error)
+	WaitForJobWithOptions(ctx context.Context, jobID string, opts client.WaitForJobOptions) (*client.Job, error)
+}
+
 // VirtualMachineResource defines the resource implementation.
 type VirtualMachineResource struct {
-	client *client.Client
+	client vmClient
+
+	// batch is non-nil when the provider's batching block is enabled; see
+	// ProviderData and client.BatchClient.
+	batch *client.BatchClient
+
+	// secrets is non-nil when the provider's vault block is configured;
+	// see ProviderData and resolveSecret.
+	secrets *secrets.Client
+
+	// ipam is non-nil when the provider's ipam block is configured; see
+	// ProviderData and createNetworkInterfaces.
+	ipam *ipam.Client
 }
 
 // VirtualMachineResourceModel describes the resource data model.
@@ -48,18 +97,29 @@ type VirtualMachineResourceModel struct {
 	Host    types.String `tfsdk:"host"`
 	Cluster types.String `tfsdk:"cluster"`
 
+	// ProvisioningJobID is set as soon as the server accepts a create
+	// request and cleared once that job completes. If it's non-empty on
+	// Read, the VM creation job was still in flight the last time
+	// Terraform touched this resource (e.g. it was killed mid-apply), and
+	// Read resumes by polling that job instead of looking up the VM
+	// directly, so a subsequent apply can't orphan a duplicate.
+	ProvisioningJobID types.String `tfsdk:"provisioning_job_id"`
+
 	// Compute
 	CPUCores        types.Int64   `tfsdk:"cpu_cores"`
+	CPU             types.Object  `tfsdk:"cpu"`
 	StartupMemoryGB types.Float64 `tfsdk:"startup_memory_gb"`
 	DynamicMemory   types.Object  `tfsdk:"dynamic_memory"`
+	NUMA            types.Object  `tfsdk:"numa"`
 
 	// Security
-	SecureBoot             types.String `tfsdk:"secure_boot"`
-	TrustedPlatformModule  types.Object `tfsdk:"trusted_platform_module"`
+	SecureBoot            types.String `tfsdk:"secure_boot"`
+	TrustedPlatformModule types.Object `tfsdk:"trusted_platform_module"`
 
 	// Storage
 	BootDisk types.Object `tfsdk:"boot_disk"`
 	Disks    types.List   `tfsdk:"disk"`
+	CDROMs   types.List   `tfsdk:"cdrom"`
 
 	// Networking
 	NetworkInterfaces types.List `tfsdk:"network_if"`
@@ -69,11 +129,29 @@ type VirtualMachineResourceModel struct {
 	User                 types.Object `tfsdk:"user"`
 	DomainJoin           types.Object `tfsdk:"domain_join"`
 	Ansible              types.Object `tfsdk:"ansible"`
+	Windows              types.Object `tfsdk:"windows"`
+	Linux                types.Object `tfsdk:"linux"`
+	CloudInit            types.Object `tfsdk:"cloud_init"`
+	CloudInitISOPath     types.String `tfsdk:"cloud_init_iso_path"`
 
 	// Behavior
 	HostRecoveryAction types.String `tfsdk:"host_recovery_action"`
 	HostStopAction     types.String `tfsdk:"host_stop_action"`
 	IntegrationSvcs    types.Object `tfsdk:"integration_svcs"`
+
+	// Checkpoints
+	CheckpointType              types.String `tfsdk:"checkpoint_type"`
+	AutomaticCheckpointsEnabled types.Bool   `tfsdk:"automatic_checkpoints_enabled"`
+
+	// ForceDeleteCheckpoints allows Delete to proceed when this VM has
+	// aetherv_virtual_machine_checkpoint children, deleting them first
+	// instead of blocking; see Delete.
+	ForceDeleteCheckpoints types.Bool `tfsdk:"force_delete_checkpoints"`
+
+	// Tags are reconciled via the shared internal/provider/tagging package,
+	// the same helper disks, NICs, networks, and images are expected to
+	// reuse as they grow tagging support.
+	Tags map[string]string `tfsdk:"tags"`
 }
 
 // DynamicMemoryModel describes the dynamic memory configuration.
@@ -81,6 +159,25 @@ type DynamicMemoryModel struct {
 	MemoryGBMin       types.Float64 `tfsdk:"memory_gb_min"`
 	MemoryGBMax       types.Float64 `tfsdk:"memory_gb_max"`
 	MemoryPrcntBuffer types.Int64   `tfsdk:"memory_prcnt_buffer"`
+	MemoryPriority    types.Int64   `tfsdk:"memory_priority"`
+	MemoryWeight      types.Int64   `tfsdk:"memory_weight"`
+}
+
+// CPUModel describes CPU resource controls beyond core count.
+type CPUModel struct {
+	ReservationPercent types.Int64 `tfsdk:"reservation_percent"`
+	LimitPercent       types.Int64 `tfsdk:"limit_percent"`
+	Weight             types.Int64 `tfsdk:"weight"`
+	CompatibilityMode  types.Bool  `tfsdk:"compatibility_mode"`
+}
+
+// NUMAModel describes NUMA topology overrides for the virtual machine.
+// These only take effect while the VM is powered off.
+type NUMAModel struct {
+	ProcessorsPerNode types.Int64   `tfsdk:"processors_per_node"`
+	MemoryPerNodeGB   types.Float64 `tfsdk:"memory_per_node_gb"`
+	NodesPerSocket    types.Int64   `tfsdk:"nodes_per_socket"`
+	SpanningEnabled   types.Bool    `tfsdk:"spanning_enabled"`
 }
 
 // TPMModel describes trusted platform module configuration.
@@ -90,64 +187,148 @@ type TPMModel struct {
 
 // BootDiskModel describes the boot disk configuration.
 type BootDiskModel struct {
-	ID           types.String  `tfsdk:"id"`
-	SourceImage  types.String  `tfsdk:"source_image"`
-	ExpandToGB   types.Float64 `tfsdk:"expand_to_gb"`
-	StorageClass types.String  `tfsdk:"storage_class"`
+	ID                 types.String  `tfsdk:"id"`
+	SourceImage        types.String  `tfsdk:"source_image"`
+	ExpandToGB         types.Float64 `tfsdk:"expand_to_gb"`
+	StorageClass       types.String  `tfsdk:"storage_class"`
+	ControllerType     types.String  `tfsdk:"controller_type"`
+	ControllerNumber   types.Int64   `tfsdk:"controller_number"`
+	ControllerLocation types.Int64   `tfsdk:"controller_location"`
+	Provisioning       types.String  `tfsdk:"provisioning"`
+	MaxIOPS            types.Int64   `tfsdk:"max_iops"`
+	MinIOPS            types.Int64   `tfsdk:"min_iops"`
 }
 
 // DiskModel describes additional disk configuration.
 type DiskModel struct {
-	ID           types.String  `tfsdk:"id"`
-	SizeGB       types.Float64 `tfsdk:"size_gb"`
-	StorageClass types.String  `tfsdk:"storage_class"`
+	ID                 types.String  `tfsdk:"id"`
+	SizeGB             types.Float64 `tfsdk:"size_gb"`
+	StorageClass       types.String  `tfsdk:"storage_class"`
+	ControllerType     types.String  `tfsdk:"controller_type"`
+	ControllerNumber   types.Int64   `tfsdk:"controller_number"`
+	ControllerLocation types.Int64   `tfsdk:"controller_location"`
+	Provisioning       types.String  `tfsdk:"provisioning"`
+	MaxIOPS            types.Int64   `tfsdk:"max_iops"`
+	MinIOPS            types.Int64   `tfsdk:"min_iops"`
+}
+
+// CDROMModel describes a CD/DVD drive attached to the virtual machine.
+type CDROMModel struct {
+	ID           types.String `tfsdk:"id"`
+	SourceISO    types.String `tfsdk:"source_iso"`
+	StorageClass types.String `tfsdk:"storage_class"`
+	EjectOnBoot  types.Bool   `tfsdk:"eject_on_boot"`
 }
 
 // NetworkInterfaceModel describes a network interface configuration.
 type NetworkInterfaceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Network         types.String `tfsdk:"network"`
-	DHCPGuard       types.Bool   `tfsdk:"dhcp_guard"`
-	RouterGuard     types.Bool   `tfsdk:"router_guard"`
-	MACSpoofGuard   types.Bool   `tfsdk:"mac_spoof_guard"`
-	MACAddress      types.String `tfsdk:"mac_address"`
+	ID               types.String `tfsdk:"id"`
+	Network          types.String `tfsdk:"network"`
+	AdapterType      types.String `tfsdk:"adapter_type"`
+	DHCPGuard        types.Bool   `tfsdk:"dhcp_guard"`
+	RouterGuard      types.Bool   `tfsdk:"router_guard"`
+	MACSpoofGuard    types.Bool   `tfsdk:"mac_spoof_guard"`
+	MACAddress       types.String `tfsdk:"mac_address"`
 	MinBandwidthMbps types.Int64  `tfsdk:"min_bandwidth_mbps"`
 	MaxBandwidthMbps types.Int64  `tfsdk:"max_bandwidth_mbps"`
-	IPConfig        types.Object `tfsdk:"ipconfig"`
+	VlanID           types.Int64  `tfsdk:"vlan_id"`
+	VlanTrunk        types.List   `tfsdk:"vlan_trunk"`
+	SRIOV            types.Bool   `tfsdk:"sr_iov"`
+	VMQ              types.Bool   `tfsdk:"vmq"`
+	DeviceNaming     types.Bool   `tfsdk:"device_naming"`
+	IPConfig         types.List   `tfsdk:"ipconfig"`
 }
 
-// IPConfigModel describes IP configuration for a network interface.
+// IPConfigModel describes a single IP configuration entry for a network
+// interface. A network interface can have several of these (e.g. a primary
+// address plus one or more VIPs/secondary addresses on the same adapter).
+// When Allocation is "ipam", the provider resolves the address against the
+// provider's ipam block when the network interface is created, instead of
+// requiring it inline; see (*VirtualMachineResource).createNetworkInterfaces.
 type IPConfigModel struct {
+	Primary      types.Bool   `tfsdk:"primary"`
+	Allocation   types.String `tfsdk:"allocation"`
 	IPv4         types.Object `tfsdk:"ipv4"`
 	IPv6         types.Object `tfsdk:"ipv6"`
 	SearchSuffix types.String `tfsdk:"search_suffix"`
 }
 
-// IPAddressConfigModel describes IPv4 or IPv6 configuration.
+// IPAddressConfigModel describes IPv4 or IPv6 configuration. Address and
+// Gateway are only meaningful (and required) when Mode is "static"; see
+// validateIPAddressConfig.
 type IPAddressConfigModel struct {
-	Address types.String `tfsdk:"address"`
-	Gateway types.String `tfsdk:"gateway"`
-	DNS     types.List   `tfsdk:"dns"`
+	Mode              types.String `tfsdk:"mode"`
+	Address           types.String `tfsdk:"address"`
+	Gateway           types.String `tfsdk:"gateway"`
+	DNS               types.List   `tfsdk:"dns"`
+	PrivacyExtensions types.Bool   `tfsdk:"privacy_extensions"`
+	AcceptRA          types.Bool   `tfsdk:"accept_ra"`
 }
 
-// UserModel describes the local user configuration.
+// UserModel describes the local user configuration. Exactly one of
+// Password or PasswordVaultRef must be set; see (*VirtualMachineResource).ValidateConfig.
 type UserModel struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Username         types.String `tfsdk:"username"`
+	Password         types.String `tfsdk:"password"`
+	PasswordVaultRef types.Object `tfsdk:"password_vault_ref"`
 }
 
-// DomainJoinModel describes domain join configuration.
+// DomainJoinModel describes domain join configuration. Exactly one of
+// Password or PasswordVaultRef must be set; see (*VirtualMachineResource).ValidateConfig.
 type DomainJoinModel struct {
 	DomainName         types.String `tfsdk:"domain_name"`
 	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
 	Username           types.String `tfsdk:"username"`
 	Password           types.String `tfsdk:"password"`
+	PasswordVaultRef   types.Object `tfsdk:"password_vault_ref"`
 }
 
-// AnsibleModel describes Ansible configuration.
+// AnsibleModel describes Ansible configuration. Exactly one of SSHKey or
+// SSHKeyVaultRef must be set; see (*VirtualMachineResource).ValidateConfig.
 type AnsibleModel struct {
-	Username types.String `tfsdk:"username"`
-	SSHKey   types.String `tfsdk:"ssh_key"`
+	Username       types.String `tfsdk:"username"`
+	SSHKey         types.String `tfsdk:"ssh_key"`
+	SSHKeyVaultRef types.Object `tfsdk:"ssh_key_vault_ref"`
+}
+
+// VaultRefModel is a reference to a secret stored in HashiCorp Vault, used
+// as an alternative to supplying a guest-customization secret inline. See
+// the provider's vault block and internal/secrets.
+type VaultRefModel struct {
+	Path  types.String `tfsdk:"path"`
+	Field types.String `tfsdk:"field"`
+}
+
+// WindowsModel describes Windows-specific Sysprep specialization, beyond
+// the user/domain_join blocks shared with Linux.
+type WindowsModel struct {
+	ProductKey         types.String `tfsdk:"product_key"`
+	Timezone           types.String `tfsdk:"timezone"`
+	Locale             types.String `tfsdk:"locale"`
+	ComputerName       types.String `tfsdk:"computer_name"`
+	AutoLogonCount     types.Int64  `tfsdk:"auto_logon_count"`
+	FirstLogonCommands types.List   `tfsdk:"first_logon_commands"`
+}
+
+// LinuxModel describes Linux-specific cloud-init specialization, beyond the
+// user/ansible blocks shared with Windows.
+type LinuxModel struct {
+	Hostname types.String `tfsdk:"hostname"`
+	Timezone types.String `tfsdk:"timezone"`
+	Locale   types.String `tfsdk:"locale"`
+	RunCmd   types.List   `tfsdk:"run_cmd"`
+}
+
+// CloudInitModel describes cloud-init/Ignition specialization, the
+// NoCloud-style counterpart to the user/domain_join/windows sysprep path
+// used for Linux and CoreOS/Flatcar guests. UserData may reference
+// cloudInitSSHKeyPlaceholder to pull in the ansible block's SSH key instead
+// of duplicating it; see renderCloudInitUserData.
+type CloudInitModel struct {
+	UserData      types.String `tfsdk:"user_data"`
+	MetaData      types.String `tfsdk:"meta_data"`
+	NetworkConfig types.String `tfsdk:"network_config"`
+	Datasource    types.String `tfsdk:"datasource"`
 }
 
 // IntegrationServicesModel describes Hyper-V integration services.
@@ -164,8 +345,122 @@ func (r *VirtualMachineResource) Metadata(ctx context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_virtual_machine"
 }
 
+// mergeAttributes combines one or more attribute maps into a new map, for
+// schema blocks that share a common set of attributes (e.g. boot_disk and
+// disk both take diskPlacementAttributes()).
+func mergeAttributes(maps ...map[string]schema.Attribute) map[string]schema.Attribute {
+	merged := make(map[string]schema.Attribute)
+	for _, m := range maps {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// vaultRefAttribute returns the schema for a *_vault_ref attribute, the
+// Vault-path-and-field indirection offered alongside an inline secret
+// attribute throughout guest specialization (see VaultRefModel).
+func vaultRefAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The Vault path the secret is stored at, e.g. \"kv/data/vms/web01\" for a KV v2 mount.",
+			},
+			"field": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The field within the secret at path to use.",
+			},
+		},
+	}
+}
+
+// diskPlacementAttributes returns the controller/provisioning/QoS
+// attributes shared by the boot_disk and disk blocks.
+func diskPlacementAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"controller_type": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("SCSI"),
+			MarkdownDescription: "The disk controller type: 'IDE', 'SCSI', or 'SCSI-ReservedSlot'. Defaults to 'SCSI'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("IDE", "SCSI", "SCSI-ReservedSlot"),
+			},
+		},
+		"controller_number": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+			MarkdownDescription: "The controller number the disk is attached to. Defaults to 0.",
+		},
+		"controller_location": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "The LUN/location on the controller. If omitted, the server assigns the next available slot in list order, so plans stay stable across applies.",
+		},
+		"provisioning": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("dynamic"),
+			MarkdownDescription: "The VHDX provisioning mode: 'dynamic', 'fixed', or 'differencing'. Defaults to 'dynamic'.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("dynamic", "fixed", "differencing"),
+			},
+		},
+		"max_iops": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Upper bound on IOPS enforced by a storage QoS policy. Unlimited if unset.",
+		},
+		"min_iops": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Minimum reserved IOPS enforced by a storage QoS policy. Unset means no reservation.",
+		},
+	}
+}
+
+// vlanTrunkValidator rejects vlan_trunk unless the sibling vlan_id is 0;
+// Hyper-V treats access mode (a single tagged vlan_id) and trunk mode
+// (vlan_trunk) as mutually exclusive.
+type vlanTrunkValidator struct{}
+
+func (v vlanTrunkValidator) Description(ctx context.Context) string {
+	return "vlan_trunk can only be set when vlan_id is 0"
+}
+
+func (v vlanTrunkValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v vlanTrunkValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || len(req.ConfigValue.Elements()) == 0 {
+		return
+	}
+
+	var vlanID types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("vlan_id"), &vlanID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !vlanID.IsNull() && !vlanID.IsUnknown() && vlanID.ValueInt64() != 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid VLAN Configuration",
+			"vlan_trunk cannot be set while vlan_id is non-zero; use vlan_id alone for access mode, or set vlan_id to 0 alongside vlan_trunk for trunk mode.",
+		)
+	}
+}
+
 func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1: network_if.ipconfig became a list of entries instead of
+		// a single object, to support secondary addresses per adapter; see
+		// UpgradeState.
+		Version: 1,
+
 		MarkdownDescription: "Manages an Aether-V virtual machine including its disks, network interfaces, and guest configuration.",
 
 		Attributes: map[string]schema.Attribute{
@@ -192,19 +487,59 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 				Optional:            true,
 				MarkdownDescription: "The cluster for the VM. Required if host is not set. Enables failover.",
 			},
+			"provisioning_job_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the in-flight job provisioning this VM. Set as soon as Create is accepted by the server and cleared once the job completes; non-empty here means a prior apply was interrupted before provisioning finished.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 
 			// Compute
 			"cpu_cores": schema.Int64Attribute{
 				Required:            true,
 				MarkdownDescription: "The number of CPU cores for the virtual machine (1-64).",
 			},
+			"cpu": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "CPU resource controls beyond core count. reservation_percent, limit_percent, and weight are hot-changeable; compatibility_mode takes effect on the VM's next cold start.",
+				Attributes: map[string]schema.Attribute{
+					"reservation_percent": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Minimum percentage of host CPU guaranteed to this VM (0-100).",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"limit_percent": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum percentage of host CPU this VM may consume (0-100).",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"weight": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Relative CPU weight used to arbitrate contention when reservations are oversubscribed (1-10000).",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 10000),
+						},
+					},
+					"compatibility_mode": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Limit the processor features exposed to the guest to those common across the host's CPU generation family, so the VM can live-migrate to older hardware.",
+					},
+				},
+			},
 			"startup_memory_gb": schema.Float64Attribute{
 				Required:            true,
 				MarkdownDescription: "The startup memory in GB for the virtual machine.",
 			},
 			"dynamic_memory": schema.SingleNestedAttribute{
 				Optional:            true,
-				MarkdownDescription: "Dynamic memory configuration for the virtual machine.",
+				MarkdownDescription: "Dynamic memory configuration for the virtual machine. All fields here are hot-changeable.",
 				Attributes: map[string]schema.Attribute{
 					"memory_gb_min": schema.Float64Attribute{
 						Optional:            true,
@@ -218,6 +553,44 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 						Optional:            true,
 						MarkdownDescription: "Memory buffer percentage.",
 					},
+					"memory_priority": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Relative memory priority used to arbitrate contention when dynamic memory is oversubscribed (0-100).",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"memory_weight": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Relative memory weight, analogous to cpu.weight, used where the server supports it instead of memory_priority.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 10000),
+						},
+					},
+				},
+			},
+			"numa": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "NUMA topology overrides for the virtual machine's virtual processors. These only take effect while the VM is powered off; changing them on a running VM is flagged on the next apply instead of being silently deferred.",
+				Attributes: map[string]schema.Attribute{
+					"processors_per_node": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum virtual processors per NUMA node.",
+					},
+					"memory_per_node_gb": schema.Float64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum memory in GB per NUMA node.",
+					},
+					"nodes_per_socket": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum NUMA nodes per virtual socket.",
+					},
+					"spanning_enabled": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+						MarkdownDescription: "Allow a VM's virtual processors to span multiple physical NUMA nodes if it doesn't fit in one. Disabling this improves memory locality but can prevent the VM from starting if no single node has enough capacity.",
+					},
 				},
 			},
 
@@ -249,7 +622,7 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 			"boot_disk": schema.SingleNestedAttribute{
 				Required:            true,
 				MarkdownDescription: "Boot disk configuration. Dictates boot order.",
-				Attributes: map[string]schema.Attribute{
+				Attributes: mergeAttributes(map[string]schema.Attribute{
 					"id": schema.StringAttribute{
 						Computed:            true,
 						MarkdownDescription: "The unique identifier of the boot disk (GUID).",
@@ -272,7 +645,7 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 						Optional:            true,
 						MarkdownDescription: "Storage class for the disk.",
 					},
-				},
+				}, diskPlacementAttributes()),
 			},
 
 			// Storage - Additional Disks
@@ -280,7 +653,7 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 				Optional:            true,
 				MarkdownDescription: "Additional data disks for the virtual machine.",
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: mergeAttributes(map[string]schema.Attribute{
 						"id": schema.StringAttribute{
 							Computed:            true,
 							MarkdownDescription: "The unique identifier of the disk (GUID).",
@@ -296,6 +669,37 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 							Optional:            true,
 							MarkdownDescription: "Storage class for the disk.",
 						},
+					}, diskPlacementAttributes()),
+				},
+			},
+
+			// Storage - CD/DVD Drives
+			"cdrom": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "CD/DVD drives attached to the virtual machine, e.g. for driver injection, rescue boots, or manual OS installs.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the CD/DVD drive (GUID).",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"source_iso": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Path to the ISO to mount, on a configured image store.",
+						},
+						"storage_class": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Storage class for the drive.",
+						},
+						"eject_on_boot": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Eject the mounted ISO once the VM has booted.",
+						},
 					},
 				},
 			},
@@ -317,6 +721,18 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 							Required:            true,
 							MarkdownDescription: "The virtual switch or network name.",
 						},
+						"adapter_type": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("synthetic"),
+							MarkdownDescription: "Network adapter type: 'synthetic' (default; requires integration services) or 'legacy' (BIOS-compatible, e.g. for PXE boot). Hyper-V cannot hot-swap between the two, so changing this forces replacement.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("synthetic", "legacy"),
+							},
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
 						"dhcp_guard": schema.BoolAttribute{
 							Optional:            true,
 							Computed:            true,
@@ -349,51 +765,149 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 							Optional:            true,
 							MarkdownDescription: "Maximum bandwidth in Mbps.",
 						},
-						"ipconfig": schema.SingleNestedAttribute{
+						"vlan_id": schema.Int64Attribute{
 							Optional:            true,
-							MarkdownDescription: "Static IP configuration for the network interface.",
-							Attributes: map[string]schema.Attribute{
-								"ipv4": schema.SingleNestedAttribute{
-									Optional:            true,
-									MarkdownDescription: "IPv4 configuration.",
-									Attributes: map[string]schema.Attribute{
-										"address": schema.StringAttribute{
-											Required:            true,
-											MarkdownDescription: "IPv4 address with prefix (e.g., '192.168.1.10/24').",
-										},
-										"gateway": schema.StringAttribute{
-											Required:            true,
-											MarkdownDescription: "Default gateway address.",
-										},
-										"dns": schema.ListAttribute{
-											Required:            true,
-											ElementType:         types.StringType,
-											MarkdownDescription: "DNS server addresses (max 2).",
-										},
+							Computed:            true,
+							Default:             int64default.StaticInt64(0),
+							MarkdownDescription: "Access-mode VLAN ID (0-4094). 0 means untagged. Mutually exclusive with vlan_trunk; can be changed in place.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 4094),
+							},
+						},
+						"vlan_trunk": schema.ListAttribute{
+							Optional:            true,
+							ElementType:         types.Int64Type,
+							MarkdownDescription: "Allowed VLAN IDs when this adapter is in trunk mode. Only valid when vlan_id is 0.",
+							Validators: []validator.List{
+								vlanTrunkValidator{},
+							},
+						},
+						"sr_iov": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Enable SR-IOV for this adapter, bypassing the Hyper-V switch on compatible hardware.",
+						},
+						"vmq": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Enable Virtual Machine Queue (VMQ) for this adapter.",
+						},
+						"device_naming": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Enable consistent device naming so the guest OS sees a stable NIC name instead of one that depends on enumeration order.",
+						},
+						"ipconfig": schema.ListNestedAttribute{
+							Optional:            true,
+							MarkdownDescription: "IP configurations for the network interface. Several entries are allowed on one adapter for secondary addresses/VIPs; exactly one entry should set primary = true.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"primary": schema.BoolAttribute{
+										Optional:            true,
+										Computed:            true,
+										Default:             booldefault.StaticBool(false),
+										MarkdownDescription: "Whether this is the adapter's primary address. Exactly one ipconfig entry per adapter should set this.",
 									},
-								},
-								"ipv6": schema.SingleNestedAttribute{
-									Optional:            true,
-									MarkdownDescription: "IPv6 configuration.",
-									Attributes: map[string]schema.Attribute{
-										"address": schema.StringAttribute{
-											Required:            true,
-											MarkdownDescription: "IPv6 address with prefix.",
+									"allocation": schema.StringAttribute{
+										Optional:            true,
+										Computed:            true,
+										Default:             stringdefault.StaticString("static"),
+										MarkdownDescription: "How this entry's address is assigned: 'static' (address/gateway supplied inline), 'dynamic' (left to the guest's own ipv4/ipv6 mode, e.g. dhcp/slaac), or 'ipam' (resolved from the provider's ipam block when the network interface is created).",
+										Validators: []validator.String{
+											stringvalidator.OneOf("static", "dynamic", "ipam"),
 										},
-										"gateway": schema.StringAttribute{
-											Required:            true,
-											MarkdownDescription: "Default gateway address.",
+									},
+									"ipv4": schema.SingleNestedAttribute{
+										Optional:            true,
+										MarkdownDescription: "IPv4 configuration.",
+										Attributes: map[string]schema.Attribute{
+											"mode": schema.StringAttribute{
+												Optional:            true,
+												Computed:            true,
+												Default:             stringdefault.StaticString("static"),
+												MarkdownDescription: "IPv4 assignment mode: 'static' or 'dhcp'. address/gateway are required when 'static' and must be unset otherwise.",
+												Validators: []validator.String{
+													stringvalidator.OneOf("static", "dhcp"),
+												},
+											},
+											"address": schema.StringAttribute{
+												Optional:            true,
+												Computed:            true,
+												MarkdownDescription: "IPv4 address with prefix (e.g., '192.168.1.10/24'). Required when mode is 'static'; resolved by the provider when allocation is 'ipam'.",
+												PlanModifiers: []planmodifier.String{
+													stringplanmodifier.UseStateForUnknown(),
+												},
+											},
+											"gateway": schema.StringAttribute{
+												Optional:            true,
+												MarkdownDescription: "Default gateway address. Required when mode is 'static'.",
+											},
+											"dns": schema.ListAttribute{
+												Required:            true,
+												ElementType:         types.StringType,
+												MarkdownDescription: "DNS server addresses (max 2).",
+											},
+											"privacy_extensions": schema.BoolAttribute{
+												Optional:            true,
+												Computed:            true,
+												Default:             booldefault.StaticBool(false),
+												MarkdownDescription: "Not applicable to IPv4; present only for attribute symmetry with ipv6.",
+											},
+											"accept_ra": schema.BoolAttribute{
+												Optional:            true,
+												Computed:            true,
+												Default:             booldefault.StaticBool(true),
+												MarkdownDescription: "Not applicable to IPv4; present only for attribute symmetry with ipv6.",
+											},
 										},
-										"dns": schema.ListAttribute{
-											Required:            true,
-											ElementType:         types.StringType,
-											MarkdownDescription: "DNS server addresses (max 2).",
+									},
+									"ipv6": schema.SingleNestedAttribute{
+										Optional:            true,
+										MarkdownDescription: "IPv6 configuration.",
+										Attributes: map[string]schema.Attribute{
+											"mode": schema.StringAttribute{
+												Optional:            true,
+												Computed:            true,
+												Default:             stringdefault.StaticString("static"),
+												MarkdownDescription: "IPv6 assignment mode: 'static', 'dhcp', 'slaac', 'dhcpv6_stateless', or 'link_local_only'. address/gateway are required when 'static' and must be unset otherwise.",
+												Validators: []validator.String{
+													stringvalidator.OneOf("static", "dhcp", "slaac", "dhcpv6_stateless", "link_local_only"),
+												},
+											},
+											"address": schema.StringAttribute{
+												Optional:            true,
+												MarkdownDescription: "IPv6 address with prefix. Required when mode is 'static'.",
+											},
+											"gateway": schema.StringAttribute{
+												Optional:            true,
+												MarkdownDescription: "Default gateway address. Required when mode is 'static'.",
+											},
+											"dns": schema.ListAttribute{
+												Required:            true,
+												ElementType:         types.StringType,
+												MarkdownDescription: "DNS server addresses (max 2).",
+											},
+											"privacy_extensions": schema.BoolAttribute{
+												Optional:            true,
+												Computed:            true,
+												Default:             booldefault.StaticBool(false),
+												MarkdownDescription: "Enable IPv6 privacy extensions (RFC 4941) to use temporary, randomized addresses instead of ones derived from the interface's MAC address. Only meaningful for 'slaac' and 'dhcpv6_stateless' modes.",
+											},
+											"accept_ra": schema.BoolAttribute{
+												Optional:            true,
+												Computed:            true,
+												Default:             booldefault.StaticBool(true),
+												MarkdownDescription: "Accept IPv6 Router Advertisements. Disable for 'static' or 'link_local_only' setups that must not auto-configure from RA.",
+											},
 										},
 									},
-								},
-								"search_suffix": schema.StringAttribute{
-									Optional:            true,
-									MarkdownDescription: "DNS search suffix.",
+									"search_suffix": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "DNS search suffix.",
+									},
 								},
 							},
 						},
@@ -418,10 +932,11 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 						MarkdownDescription: "Local administrator username.",
 					},
 					"password": schema.StringAttribute{
-						Required:            true,
+						Optional:            true,
 						Sensitive:           true,
-						MarkdownDescription: "Local administrator password.",
+						MarkdownDescription: "Local administrator password. Exactly one of password or password_vault_ref is required.",
 					},
+					"password_vault_ref": vaultRefAttribute("Resolves the local administrator password from HashiCorp Vault instead of supplying it inline. Requires the provider's vault block. Exactly one of password or password_vault_ref is required."),
 				},
 			},
 			"domain_join": schema.SingleNestedAttribute{
@@ -441,10 +956,11 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 						MarkdownDescription: "Username with domain join privileges.",
 					},
 					"password": schema.StringAttribute{
-						Required:            true,
+						Optional:            true,
 						Sensitive:           true,
-						MarkdownDescription: "Password for domain join.",
+						MarkdownDescription: "Password for domain join. Exactly one of password or password_vault_ref is required.",
 					},
+					"password_vault_ref": vaultRefAttribute("Resolves the domain join password from HashiCorp Vault instead of supplying it inline. Requires the provider's vault block. Exactly one of password or password_vault_ref is required."),
 				},
 			},
 			"ansible": schema.SingleNestedAttribute{
@@ -456,12 +972,112 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 						MarkdownDescription: "Ansible SSH username.",
 					},
 					"ssh_key": schema.StringAttribute{
-						Required:            true,
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Ansible SSH public key. Exactly one of ssh_key or ssh_key_vault_ref is required.",
+					},
+					"ssh_key_vault_ref": vaultRefAttribute("Resolves the Ansible SSH public key from HashiCorp Vault instead of supplying it inline. Requires the provider's vault block. Exactly one of ssh_key or ssh_key_vault_ref is required."),
+				},
+			},
+			"windows": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Windows-specific Sysprep specialization. Only applies when specialization_family is 'windows'. These settings are only applied once, during the guest initialize call after Create, so changing any of them forces replacement.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"product_key": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Windows product key to apply during Sysprep.",
+					},
+					"timezone": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The guest's timezone, as either a Windows numeric timezone index (e.g. '035' for Eastern Standard Time) or an IANA name (e.g. 'America/New_York'); the server maps IANA names to the corresponding Windows index.",
+					},
+					"locale": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The guest's locale/input locale, e.g. 'en-US'.",
+					},
+					"computer_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Override for the guest's computer name. Defaults to the virtual machine's name if unset.",
+					},
+					"auto_logon_count": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of times to automatically log on as the local administrator after Sysprep completes.",
+					},
+					"first_logon_commands": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Commands to run, in order, the first time a user logs on after Sysprep completes.",
+					},
+				},
+			},
+			"linux": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Linux-specific cloud-init specialization. Only applies when specialization_family is 'linux'. These settings are only applied once, during the guest initialize call after Create, so changing any of them forces replacement.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"hostname": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Override for the guest's hostname. Defaults to the virtual machine's name if unset.",
+					},
+					"timezone": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The guest's timezone as an IANA name, e.g. 'America/New_York'.",
+					},
+					"locale": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The guest's locale, e.g. 'en_US.UTF-8'.",
+					},
+					"run_cmd": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Commands to run, in order, during first boot (cloud-init runcmd).",
+					},
+				},
+			},
+			"cloud_init": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Raw cloud-init or Ignition guest customization, as an alternative to the user/domain_join/windows sysprep path. Hyper-V has no native cloud-init datasource, so the provider renders this into an ISO and attaches it as a CD/DVD drive; see cloud_init_iso_path. Mutually exclusive with user and domain_join. Only applied once, during the guest initialize call after Create, so changing any of these forces replacement.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"user_data": schema.StringAttribute{
+						Optional:            true,
 						Sensitive:           true,
-						MarkdownDescription: "Ansible SSH public key.",
+						MarkdownDescription: "Raw cloud-init user-data (or an Ignition config when datasource is 'ignition'), as a literal string or a file() reference. May embed the literal token '{{ansible_ssh_key}}' to splice in the resolved ansible.ssh_key instead of duplicating it here.",
+					},
+					"meta_data": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Raw cloud-init meta-data, as a literal string or a file() reference.",
+					},
+					"network_config": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Raw cloud-init network-config, as a literal string or a file() reference.",
+					},
+					"datasource": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("nocloud"),
+						MarkdownDescription: "The datasource format to render: 'nocloud', 'configdrive-v2', or 'ignition'.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("nocloud", "configdrive-v2", "ignition"),
+						},
 					},
 				},
 			},
+			"cloud_init_iso_path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The path of the ISO the provider materialized from cloud_init and attached to the virtual machine. Empty if cloud_init is not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 
 			// Behavior
 			"host_recovery_action": schema.StringAttribute{
@@ -482,6 +1098,27 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 					stringvalidator.OneOf("save", "stop", "shut-down"),
 				},
 			},
+			"checkpoint_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("production"),
+				MarkdownDescription: "The checkpoint type Hyper-V uses for both automatic checkpoints and aetherv_virtual_machine_checkpoint resources against this VM: 'production' (VSS/application-consistent, falling back to a saved-state checkpoint if the guest doesn't support VSS), 'production_fallback' (same, but never falls back silently; the checkpoint fails instead), 'standard' (saved-state, like older Hyper-V versions), or 'disabled'. Defaults to 'production'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("production", "production_fallback", "standard", "disabled"),
+				},
+			},
+			"automatic_checkpoints_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether Hyper-V automatically creates a checkpoint before applying a configuration change to this VM. Defaults to false.",
+			},
+			"force_delete_checkpoints": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to delete this VM's aetherv_virtual_machine_checkpoint children (if any) before deleting the VM itself. Without this set, Delete fails rather than silently discarding checkpoints out from under a separately managed checkpoint resource. Defaults to false.",
+			},
 			"integration_svcs": schema.SingleNestedAttribute{
 				Optional:            true,
 				MarkdownDescription: "Hyper-V integration services configuration.",
@@ -524,34 +1161,360 @@ func (r *VirtualMachineResource) Schema(ctx context.Context, req resource.Schema
 					},
 				},
 			},
+			"tags": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Key/value tags applied to the virtual machine. Reconciled against the server on every apply and refreshed on Read, so out-of-band tag changes show up as drift.",
+			},
 		},
 	}
 }
 
-func (r *VirtualMachineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
+// UpgradeState migrates state from before network_if.ipconfig became a list
+// of entries (schema version 0, where it was a single object) to the
+// current version 1 shape, treating the old object as the sole primary,
+// statically-allocated entry.
+func (r *VirtualMachineResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   virtualMachineResourceSchemaV0(ctx),
+			StateUpgrader: upgradeVirtualMachineStateV0,
+		},
 	}
+}
 
-	client, ok := req.ProviderData.(*client.Client)
+// virtualMachineResourceSchemaV0 rebuilds the schema version 0 had by taking
+// the current schema and reverting network_if.ipconfig back to the single
+// nested object it was before the primary/allocation fields and list form
+// were added, so UpgradeState can still decode version 0 state.
+func virtualMachineResourceSchemaV0(ctx context.Context) schema.Schema {
+	var schemaResp resource.SchemaResponse
+	(&VirtualMachineResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	nic := s.Attributes["network_if"].(schema.ListNestedAttribute)
+	nicAttrs := make(map[string]schema.Attribute, len(nic.NestedObject.Attributes))
+	for k, v := range nic.NestedObject.Attributes {
+		nicAttrs[k] = v
+	}
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
+	ipconfig := nicAttrs["ipconfig"].(schema.ListNestedAttribute)
+	ipconfigAttrs := make(map[string]schema.Attribute, len(ipconfig.NestedObject.Attributes))
+	for k, v := range ipconfig.NestedObject.Attributes {
+		if k == "primary" || k == "allocation" {
+			continue
+		}
+		ipconfigAttrs[k] = v
+	}
+	nicAttrs["ipconfig"] = schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: ipconfigAttrs,
 	}
 
-	r.client = client
+	nic.NestedObject.Attributes = nicAttrs
+	s.Attributes["network_if"] = nic
+	s.Version = 0
+
+	return s
 }
 
-func (r *VirtualMachineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data VirtualMachineResourceModel
+// NetworkInterfaceModelV0 mirrors NetworkInterfaceModel as it was before
+// network_if.ipconfig became a list; used only by upgradeVirtualMachineStateV0.
+type NetworkInterfaceModelV0 struct {
+	ID               types.String `tfsdk:"id"`
+	Network          types.String `tfsdk:"network"`
+	AdapterType      types.String `tfsdk:"adapter_type"`
+	DHCPGuard        types.Bool   `tfsdk:"dhcp_guard"`
+	RouterGuard      types.Bool   `tfsdk:"router_guard"`
+	MACSpoofGuard    types.Bool   `tfsdk:"mac_spoof_guard"`
+	MACAddress       types.String `tfsdk:"mac_address"`
+	MinBandwidthMbps types.Int64  `tfsdk:"min_bandwidth_mbps"`
+	MaxBandwidthMbps types.Int64  `tfsdk:"max_bandwidth_mbps"`
+	VlanID           types.Int64  `tfsdk:"vlan_id"`
+	VlanTrunk        types.List   `tfsdk:"vlan_trunk"`
+	SRIOV            types.Bool   `tfsdk:"sr_iov"`
+	VMQ              types.Bool   `tfsdk:"vmq"`
+	DeviceNaming     types.Bool   `tfsdk:"device_naming"`
+	IPConfig         types.Object `tfsdk:"ipconfig"`
+}
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+// IPConfigModelV0 mirrors IPConfigModel as it was before primary/allocation
+// were added and ipconfig became a list; used only by
+// upgradeVirtualMachineStateV0.
+type IPConfigModelV0 struct {
+	IPv4         types.Object `tfsdk:"ipv4"`
+	IPv6         types.Object `tfsdk:"ipv6"`
+	SearchSuffix types.String `tfsdk:"search_suffix"`
+}
+
+// upgradeVirtualMachineStateV0 rewrites each network interface's single
+// ipconfig object into a one-element list whose entry is primary and
+// statically allocated, preserving the old ipv4/ipv6/search_suffix values.
+func upgradeVirtualMachineStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var priorState VirtualMachineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !priorState.NetworkInterfaces.IsNull() && !priorState.NetworkInterfaces.IsUnknown() {
+		var priorNics []NetworkInterfaceModelV0
+		resp.Diagnostics.Append(priorState.NetworkInterfaces.ElementsAs(ctx, &priorNics, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		ipConfigObjectType := types.ObjectType{AttrTypes: IPConfigAttrTypes()}
+		nics := make([]NetworkInterfaceModel, len(priorNics))
+		for i, priorNic := range priorNics {
+			nics[i] = NetworkInterfaceModel{
+				ID:               priorNic.ID,
+				Network:          priorNic.Network,
+				AdapterType:      priorNic.AdapterType,
+				DHCPGuard:        priorNic.DHCPGuard,
+				RouterGuard:      priorNic.RouterGuard,
+				MACSpoofGuard:    priorNic.MACSpoofGuard,
+				MACAddress:       priorNic.MACAddress,
+				MinBandwidthMbps: priorNic.MinBandwidthMbps,
+				MaxBandwidthMbps: priorNic.MaxBandwidthMbps,
+				VlanID:           priorNic.VlanID,
+				VlanTrunk:        priorNic.VlanTrunk,
+				SRIOV:            priorNic.SRIOV,
+				VMQ:              priorNic.VMQ,
+				DeviceNaming:     priorNic.DeviceNaming,
+			}
+
+			if priorNic.IPConfig.IsNull() || priorNic.IPConfig.IsUnknown() {
+				nics[i].IPConfig = types.ListNull(ipConfigObjectType)
+				continue
+			}
+
+			var priorIPConfig IPConfigModelV0
+			resp.Diagnostics.Append(priorNic.IPConfig.As(ctx, &priorIPConfig, basetypes.ObjectAsOptions{})...)
+
+			ipConfigList, diags := types.ListValueFrom(ctx, ipConfigObjectType, []IPConfigModel{
+				{
+					Primary:      types.BoolValue(true),
+					Allocation:   types.StringValue("static"),
+					IPv4:         priorIPConfig.IPv4,
+					IPv6:         priorIPConfig.IPv6,
+					SearchSuffix: priorIPConfig.SearchSuffix,
+				},
+			})
+			resp.Diagnostics.Append(diags...)
+			nics[i].IPConfig = ipConfigList
+		}
+
+		updated, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: NetworkInterfaceAttrTypes()}, nics)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		priorState.NetworkInterfaces = updated
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &priorState)...)
+}
+
+func (r *VirtualMachineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.batch = providerData.Batch
+	r.secrets = providerData.Secrets
+	r.ipam = providerData.IPAM
+}
+
+// ValidateConfig enforces that each guest-customization secret (user
+// password, domain_join password, ansible ssh_key) is supplied exactly one
+// way: inline, or via its *_vault_ref sibling, so buildGuestConfiguration
+// never has to guess which one the user meant. It also rejects network_if
+// IP configurations that mix a non-static assignment mode with an explicit
+// address or gateway (see validateIPAddressConfig), and enforces that user,
+// domain_join, and cloud_init are mutually exclusive.
+func (r *VirtualMachineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VirtualMachineResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.User.IsNull() && !data.User.IsUnknown() {
+		var user UserModel
+		resp.Diagnostics.Append(data.User.As(ctx, &user, basetypes.ObjectAsOptions{})...)
+		validateSecretRef(&resp.Diagnostics, path.Root("user"), "password", user.Password, user.PasswordVaultRef)
+	}
+
+	if !data.DomainJoin.IsNull() && !data.DomainJoin.IsUnknown() {
+		var domainJoin DomainJoinModel
+		resp.Diagnostics.Append(data.DomainJoin.As(ctx, &domainJoin, basetypes.ObjectAsOptions{})...)
+		validateSecretRef(&resp.Diagnostics, path.Root("domain_join"), "password", domainJoin.Password, domainJoin.PasswordVaultRef)
+	}
+
+	if !data.Ansible.IsNull() && !data.Ansible.IsUnknown() {
+		var ansible AnsibleModel
+		resp.Diagnostics.Append(data.Ansible.As(ctx, &ansible, basetypes.ObjectAsOptions{})...)
+		validateSecretRef(&resp.Diagnostics, path.Root("ansible"), "ssh_key", ansible.SSHKey, ansible.SSHKeyVaultRef)
+	}
+
+	exclusive := map[string]types.Object{
+		"user":        data.User,
+		"domain_join": data.DomainJoin,
+		"cloud_init":  data.CloudInit,
+	}
+	var set []string
+	for _, name := range []string{"user", "domain_join", "cloud_init"} {
+		v := exclusive[name]
+		if !v.IsNull() && !v.IsUnknown() {
+			set = append(set, name)
+		}
+	}
+	if len(set) > 1 {
+		resp.Diagnostics.AddError(
+			"Conflicting Guest Customization Blocks",
+			fmt.Sprintf("Only one of user, domain_join, or cloud_init may be set; got %s.", strings.Join(set, ", ")),
+		)
+	}
+
+	if !data.NetworkInterfaces.IsNull() && !data.NetworkInterfaces.IsUnknown() {
+		var nics []NetworkInterfaceModel
+		resp.Diagnostics.Append(data.NetworkInterfaces.ElementsAs(ctx, &nics, false)...)
+
+		for i, nic := range nics {
+			if nic.IPConfig.IsNull() || nic.IPConfig.IsUnknown() {
+				continue
+			}
+
+			var ipConfigs []IPConfigModel
+			resp.Diagnostics.Append(nic.IPConfig.ElementsAs(ctx, &ipConfigs, false)...)
+
+			primaryCount := 0
+			for j, ipConfig := range ipConfigs {
+				ipConfigPath := path.Root("network_if").AtListIndex(i).AtName("ipconfig").AtListIndex(j)
+
+				if ipConfig.Primary.ValueBool() {
+					primaryCount++
+				}
+
+				if !ipConfig.IPv4.IsNull() && !ipConfig.IPv4.IsUnknown() {
+					var ipv4 IPAddressConfigModel
+					resp.Diagnostics.Append(ipConfig.IPv4.As(ctx, &ipv4, basetypes.ObjectAsOptions{})...)
+					if ipConfig.Allocation.ValueString() == "ipam" && ipv4.Address.IsNull() {
+						// resolveIPConfigs fills the address in from the
+						// provider's ipam block at create time, so it isn't
+						// required inline and the mode/address checks are skipped.
+						ipv4.Address = types.StringUnknown()
+					}
+					validateIPAddressConfig(&resp.Diagnostics, ipConfigPath.AtName("ipv4"), ipv4)
+				}
+
+				if !ipConfig.IPv6.IsNull() && !ipConfig.IPv6.IsUnknown() {
+					var ipv6 IPAddressConfigModel
+					resp.Diagnostics.Append(ipConfig.IPv6.As(ctx, &ipv6, basetypes.ObjectAsOptions{})...)
+					validateIPAddressConfig(&resp.Diagnostics, ipConfigPath.AtName("ipv6"), ipv6)
+				}
+			}
+
+			if primaryCount > 1 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("network_if").AtListIndex(i).AtName("ipconfig"),
+					"Conflicting Primary IP Configuration",
+					fmt.Sprintf("Only one ipconfig entry may set primary = true; got %d.", primaryCount),
+				)
+			}
+		}
+	}
+}
+
+// validateIPAddressConfig rejects nonsensical mode/address combinations: a
+// "static" assignment must supply address and gateway, while every other
+// mode (dhcp, slaac, dhcpv6_stateless, link_local_only) derives its address
+// automatically and must not set either.
+func validateIPAddressConfig(diags *diag.Diagnostics, attrPath path.Path, cfg IPAddressConfigModel) {
+	if cfg.Mode.IsUnknown() || cfg.Address.IsUnknown() || cfg.Gateway.IsUnknown() {
+		return
+	}
+
+	mode := cfg.Mode.ValueString()
+	if cfg.Mode.IsNull() || mode == "" {
+		mode = "static"
+	}
+
+	addressSet := !cfg.Address.IsNull() && cfg.Address.ValueString() != ""
+	gatewaySet := !cfg.Gateway.IsNull() && cfg.Gateway.ValueString() != ""
+
+	if mode == "static" {
+		if !addressSet {
+			diags.AddAttributeError(attrPath.AtName("address"), "Missing IP Address", "address is required when mode is \"static\".")
+		}
+		if !gatewaySet {
+			diags.AddAttributeError(attrPath.AtName("gateway"), "Missing Gateway", "gateway is required when mode is \"static\".")
+		}
+		return
+	}
+
+	if addressSet {
+		diags.AddAttributeError(
+			attrPath.AtName("address"),
+			"Conflicting IP Configuration",
+			fmt.Sprintf("address must not be set when mode is %q; the address is assigned automatically.", mode),
+		)
+	}
+	if gatewaySet {
+		diags.AddAttributeError(
+			attrPath.AtName("gateway"),
+			"Conflicting IP Configuration",
+			fmt.Sprintf("gateway must not be set when mode is %q; the gateway is assigned automatically.", mode),
+		)
+	}
+}
+
+// validateSecretRef adds a diagnostic at parent.attrName unless exactly one
+// of inline or vaultRef is set. Unknown values (values not yet known at
+// plan time) are left unvalidated rather than flagged, consistent with how
+// the framework's own required/optional attribute validation behaves.
+func validateSecretRef(diags *diag.Diagnostics, parent path.Path, attrName string, inline types.String, vaultRef types.Object) {
+	if inline.IsUnknown() || vaultRef.IsUnknown() {
+		return
+	}
+
+	inlineSet := !inline.IsNull() && inline.ValueString() != ""
+	vaultRefSet := !vaultRef.IsNull()
+
+	switch {
+	case inlineSet && vaultRefSet:
+		diags.AddAttributeError(
+			parent.AtName(attrName),
+			"Conflicting Secret Configuration",
+			fmt.Sprintf("Only one of %q or %q_vault_ref may be set.", attrName, attrName),
+		)
+	case !inlineSet && !vaultRefSet:
+		diags.AddAttributeError(
+			parent.AtName(attrName),
+			"Missing Secret Configuration",
+			fmt.Sprintf("Exactly one of %q or %q_vault_ref is required.", attrName, attrName),
+		)
+	}
+}
+
+func (r *VirtualMachineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtualMachineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -561,19 +1524,747 @@ func (r *VirtualMachineResource) Create(ctx context.Context, req resource.Create
 		"name": data.Name.ValueString(),
 	})
 
-	// TODO: Implement VM creation logic
-	// 1. Create VM with VmSpec
-	// 2. Wait for job completion
-	// 3. Create boot disk with image
-	// 4. Create additional disks
-	// 5. Create network interfaces
-	// 6. Apply guest configuration via initialize endpoint
-	// 7. Read back state and populate IDs
-
-	resp.Diagnostics.AddError(
-		"Not Implemented",
-		"VM creation is not yet implemented. This is a scaffold.",
-	)
+	spec := &client.VmSpec{
+		VMName:   data.Name.ValueString(),
+		Host:     data.Host.ValueString(),
+		Cluster:  data.Cluster.ValueString(),
+		GBRam:    int(data.StartupMemoryGB.ValueFloat64()),
+		CPUCores: int(data.CPUCores.ValueInt64()),
+		OSFamily: client.OSFamily(data.SpecializationFamily.ValueString()),
+	}
+
+	// A count-heavy resource block fans out to many concurrent Create calls;
+	// when the provider's batching block is enabled, coalesce them into
+	// fewer server-side jobs instead of submitting (and polling) one per VM.
+	if r.batch != nil {
+		r.createBatched(ctx, spec, &data, resp)
+		return
+	}
+
+	jobResult, err := r.client.CreateVM(ctx, spec)
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Create Virtual Machine", err)
+		return
+	}
+
+	// Checkpoint immediately: the server has accepted the request and
+	// handed back a job ID (and, if it allocated the VM up front, a
+	// provisional ID/host). Persisting this now means a Terraform crash or
+	// SIGKILL during the job below leaves enough state for Read to resume
+	// polling rather than leaving the VM orphaned and re-created next apply.
+	data.ID = types.StringValue(jobResult.VMID)
+	data.ProvisioningJobID = types.StringValue(jobResult.JobID)
+	if jobResult.TargetHost != "" {
+		data.Host = types.StringValue(jobResult.TargetHost)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{
+		OnProgress: func(p client.JobProgress) {
+			tflog.Info(ctx, "VM provisioning progress", map[string]interface{}{
+				"job_id":       jobResult.JobID,
+				"progress_pct": p.ProgressPct,
+				"message":      p.Message,
+			})
+		},
+	})
+	if err != nil {
+		// data.ProvisioningJobID is already persisted, so the next Read
+		// will resume polling this same job instead of erroring forever.
+		r.addAPIError(&resp.Diagnostics, "Virtual Machine Provisioning Failed", err)
+		return
+	}
+
+	data.ProvisioningJobID = types.StringValue("")
+
+	// Every sub-step below is checkpointed with its own resp.State.Set as
+	// soon as it completes, not just once at the end. A VM create fans out
+	// into several of these server-side calls; without this, a failure or
+	// cancellation partway through (e.g. disks attached but guest init
+	// never ran) would leave Terraform state holding none of the IDs
+	// already allocated, and the next apply would try to create everything
+	// from scratch instead of resuming.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.createBootDisk(ctx, &data); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Create Boot Disk", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.createDisks(ctx, &data); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Create Disk", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.createNetworkInterfaces(ctx, &data); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Create Network Interface", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.attachCDROMs(ctx, &data); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Attach CD/DVD Drive", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.attachCloudInit(ctx, &data); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Attach Cloud-Init ISO", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Tags = tagging.Apply(ctx, r.client, vmTagResourceType, data.ID.ValueString(), data.Tags, nil, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyGuestConfiguration(ctx, &data); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Apply Guest Configuration", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildGuestConfiguration renders the specialization blocks actually set in
+// config (user, domain_join, ansible, windows, linux) into a
+// client.GuestCustomization. Blocks left unset are simply omitted, so the
+// server only specializes what was asked for. Any *_vault_ref secret
+// indirections are resolved against r.secrets here, so the resolved value
+// only ever reaches the outgoing API payload, never data itself (and
+// therefore never Terraform state).
+func (r *VirtualMachineResource) buildGuestConfiguration(ctx context.Context, data *VirtualMachineResourceModel) (*client.GuestCustomization, error) {
+	guestConfig := &client.GuestCustomization{
+		OSFamily: client.OSFamily(data.SpecializationFamily.ValueString()),
+	}
+
+	if !data.User.IsNull() && !data.User.IsUnknown() {
+		var user UserModel
+		if diags := data.User.As(ctx, &user, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, fmt.Errorf("invalid user configuration: %s", diags)
+		}
+		password, err := r.resolveSecret(ctx, user.Password, user.PasswordVaultRef)
+		if err != nil {
+			return nil, fmt.Errorf("user.password: %w", err)
+		}
+		guestConfig.User = &client.GuestUser{
+			Username: user.Username.ValueString(),
+			Password: password,
+		}
+	}
+
+	if !data.DomainJoin.IsNull() && !data.DomainJoin.IsUnknown() {
+		var domainJoin DomainJoinModel
+		if diags := data.DomainJoin.As(ctx, &domainJoin, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, fmt.Errorf("invalid domain_join configuration: %s", diags)
+		}
+		password, err := r.resolveSecret(ctx, domainJoin.Password, domainJoin.PasswordVaultRef)
+		if err != nil {
+			return nil, fmt.Errorf("domain_join.password: %w", err)
+		}
+		guestConfig.DomainJoin = &client.DomainJoinSpec{
+			DomainName:         domainJoin.DomainName.ValueString(),
+			OrganizationalUnit: domainJoin.OrganizationalUnit.ValueString(),
+			DomainUser:         domainJoin.Username.ValueString(),
+			DomainPassword:     password,
+		}
+	}
+
+	if !data.Ansible.IsNull() && !data.Ansible.IsUnknown() {
+		var ansible AnsibleModel
+		if diags := data.Ansible.As(ctx, &ansible, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, fmt.Errorf("invalid ansible configuration: %s", diags)
+		}
+		sshKey, err := r.resolveSecret(ctx, ansible.SSHKey, ansible.SSHKeyVaultRef)
+		if err != nil {
+			return nil, fmt.Errorf("ansible.ssh_key: %w", err)
+		}
+		guestConfig.Ansible = &client.GuestAnsible{
+			Username: ansible.Username.ValueString(),
+			SSHKey:   sshKey,
+		}
+	}
+
+	if !data.Windows.IsNull() && !data.Windows.IsUnknown() {
+		var windows WindowsModel
+		if diags := data.Windows.As(ctx, &windows, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, fmt.Errorf("invalid windows configuration: %s", diags)
+		}
+		var firstLogonCommands []string
+		if !windows.FirstLogonCommands.IsNull() && !windows.FirstLogonCommands.IsUnknown() {
+			if diags := windows.FirstLogonCommands.ElementsAs(ctx, &firstLogonCommands, false); diags.HasError() {
+				return nil, fmt.Errorf("invalid windows.first_logon_commands: %s", diags)
+			}
+		}
+		guestConfig.Timezone = windows.Timezone.ValueString()
+		guestConfig.WindowsOptions = &client.WindowsGuestOptions{
+			ProductKey:         windows.ProductKey.ValueString(),
+			ComputerName:       windows.ComputerName.ValueString(),
+			Locale:             windows.Locale.ValueString(),
+			AutoLogonCount:     int(windows.AutoLogonCount.ValueInt64()),
+			FirstLogonCommands: firstLogonCommands,
+		}
+	}
+
+	if !data.Linux.IsNull() && !data.Linux.IsUnknown() {
+		var linux LinuxModel
+		if diags := data.Linux.As(ctx, &linux, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, fmt.Errorf("invalid linux configuration: %s", diags)
+		}
+		var runCmd []string
+		if !linux.RunCmd.IsNull() && !linux.RunCmd.IsUnknown() {
+			if diags := linux.RunCmd.ElementsAs(ctx, &runCmd, false); diags.HasError() {
+				return nil, fmt.Errorf("invalid linux.run_cmd: %s", diags)
+			}
+		}
+		guestConfig.Hostname = linux.Hostname.ValueString()
+		guestConfig.Timezone = linux.Timezone.ValueString()
+		guestConfig.LinuxOptions = &client.LinuxGuestOptions{
+			Locale: linux.Locale.ValueString(),
+			RunCmd: runCmd,
+		}
+	}
+
+	if err := guestConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	return guestConfig, nil
+}
+
+// resolveSecret returns inline's value if vaultRef is unset, otherwise
+// resolves vaultRef against r.secrets. Exactly one of the two is
+// guaranteed to be set by ValidateConfig.
+func (r *VirtualMachineResource) resolveSecret(ctx context.Context, inline types.String, vaultRef types.Object) (string, error) {
+	if vaultRef.IsNull() || vaultRef.IsUnknown() {
+		return inline.ValueString(), nil
+	}
+
+	if r.secrets == nil {
+		return "", fmt.Errorf("a vault_ref was set but the provider's vault block is not configured")
+	}
+
+	var ref VaultRefModel
+	if diags := vaultRef.As(ctx, &ref, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return "", fmt.Errorf("invalid vault_ref: %s", diags)
+	}
+
+	return r.secrets.Resolve(ctx, ref.Path.ValueString(), ref.Field.ValueString())
+}
+
+// applyGuestConfiguration renders data's specialization blocks and applies
+// them via the initialize endpoint, sequenced after disks/CD-ROMs/NICs are
+// attached so the guest sees its full device set during sysprep/cloud-init.
+// It is a no-op if specialization_family was never set.
+func (r *VirtualMachineResource) applyGuestConfiguration(ctx context.Context, data *VirtualMachineResourceModel) error {
+	if data.SpecializationFamily.ValueString() == "" {
+		return nil
+	}
+
+	guestConfig, err := r.buildGuestConfiguration(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	jobResult, err := r.client.InitializeVM(ctx, data.ID.ValueString(), &client.VMInitializationRequest{
+		TargetHost:         data.Host.ValueString(),
+		GuestConfiguration: guestConfig,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{})
+	return err
+}
+
+// intPointer converts a types.Int64 to *int, returning nil for a null
+// value so it's omitted from the outgoing request instead of being sent as
+// a meaningless zero.
+func intPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	n := int(v.ValueInt64())
+	return &n
+}
+
+// createBootDisk creates data's boot disk via client.CreateDisk, mirroring
+// attachCDROMs. boot_disk.storage_class has no dedicated field on DiskSpec,
+// so it's carried over DiskType, the same bridge VmSpec.StorageClass and
+// Disk.Type already use for the VM- and disk-level equivalents.
+func (r *VirtualMachineResource) createBootDisk(ctx context.Context, data *VirtualMachineResourceModel) error {
+	var bootDisk BootDiskModel
+	if diags := data.BootDisk.As(ctx, &bootDisk, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return fmt.Errorf("invalid boot_disk configuration: %s", diags)
+	}
+
+	jobResult, err := r.client.CreateDisk(ctx, &client.DiskSpec{
+		VMID:               data.ID.ValueString(),
+		ImageName:          bootDisk.SourceImage.ValueString(),
+		DiskSizeGB:         int(bootDisk.ExpandToGB.ValueFloat64()),
+		DiskType:           bootDisk.StorageClass.ValueString(),
+		ControllerType:     bootDisk.ControllerType.ValueString(),
+		ControllerNumber:   intPointer(bootDisk.ControllerNumber),
+		ControllerLocation: intPointer(bootDisk.ControllerLocation),
+		Provisioning:       bootDisk.Provisioning.ValueString(),
+		MaxIOPS:            intPointer(bootDisk.MaxIOPS),
+		MinIOPS:            intPointer(bootDisk.MinIOPS),
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+		return err
+	}
+
+	bootDisk.ID = types.StringValue(jobResult.VMID)
+	bootDiskObj, diags := types.ObjectValueFrom(ctx, BootDiskAttrTypes(), bootDisk)
+	if diags.HasError() {
+		return fmt.Errorf("failed to encode boot_disk state: %s", diags)
+	}
+	data.BootDisk = bootDiskObj
+
+	return nil
+}
+
+// createDisks creates the additional disks configured in data.Disks via
+// client.CreateDisk, the same DiskType/StorageClass bridge createBootDisk
+// uses. It rewrites data.Disks in place with the server-assigned IDs.
+func (r *VirtualMachineResource) createDisks(ctx context.Context, data *VirtualMachineResourceModel) error {
+	if data.Disks.IsNull() || data.Disks.IsUnknown() {
+		return nil
+	}
+
+	var disks []DiskModel
+	if diags := data.Disks.ElementsAs(ctx, &disks, false); diags.HasError() {
+		return fmt.Errorf("invalid disk configuration: %s", diags)
+	}
+
+	for i, d := range disks {
+		jobResult, err := r.client.CreateDisk(ctx, &client.DiskSpec{
+			VMID:               data.ID.ValueString(),
+			DiskSizeGB:         int(d.SizeGB.ValueFloat64()),
+			DiskType:           d.StorageClass.ValueString(),
+			ControllerType:     d.ControllerType.ValueString(),
+			ControllerNumber:   intPointer(d.ControllerNumber),
+			ControllerLocation: intPointer(d.ControllerLocation),
+			Provisioning:       d.Provisioning.ValueString(),
+			MaxIOPS:            intPointer(d.MaxIOPS),
+			MinIOPS:            intPointer(d.MinIOPS),
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+			return err
+		}
+
+		disks[i].ID = types.StringValue(jobResult.VMID)
+	}
+
+	updated, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: DiskAttrTypes()}, disks)
+	if diags.HasError() {
+		return fmt.Errorf("failed to encode disk state: %s", diags)
+	}
+	data.Disks = updated
+
+	return nil
+}
+
+// createNetworkInterfaces creates the network interfaces configured in
+// data.NetworkInterfaces via client.CreateNIC. For any ipconfig entry with
+// allocation = "ipam" and no address yet, it resolves one from r.ipam
+// first; this only ever runs here, during a genuine apply, rather than in
+// ModifyPlan, so a preview-only terraform plan can never consume an
+// address from the pool. It rewrites data.NetworkInterfaces in place with
+// the server-assigned IDs and any ipam-resolved addresses.
+func (r *VirtualMachineResource) createNetworkInterfaces(ctx context.Context, data *VirtualMachineResourceModel) error {
+	if data.NetworkInterfaces.IsNull() || data.NetworkInterfaces.IsUnknown() {
+		return nil
+	}
+
+	var nics []NetworkInterfaceModel
+	if diags := data.NetworkInterfaces.ElementsAs(ctx, &nics, false); diags.HasError() {
+		return fmt.Errorf("invalid network_if configuration: %s", diags)
+	}
+
+	for i, nic := range nics {
+		ipConfigSpecs, ipConfigs, err := r.resolveIPConfigs(ctx, nic)
+		if err != nil {
+			return err
+		}
+
+		var vlanTrunk []int
+		if !nic.VlanTrunk.IsNull() && !nic.VlanTrunk.IsUnknown() {
+			if diags := nic.VlanTrunk.ElementsAs(ctx, &vlanTrunk, false); diags.HasError() {
+				return fmt.Errorf("invalid vlan_trunk configuration: %s", diags)
+			}
+		}
+
+		jobResult, err := r.client.CreateNIC(ctx, &client.NicSpec{
+			VMID:             data.ID.ValueString(),
+			Network:          nic.Network.ValueString(),
+			AdapterType:      nic.AdapterType.ValueString(),
+			DHCPGuard:        nic.DHCPGuard.ValueBoolPointer(),
+			RouterGuard:      nic.RouterGuard.ValueBoolPointer(),
+			MACSpoofGuard:    nic.MACSpoofGuard.ValueBoolPointer(),
+			MACAddress:       nic.MACAddress.ValueString(),
+			MinBandwidthMbps: intPointer(nic.MinBandwidthMbps),
+			MaxBandwidthMbps: intPointer(nic.MaxBandwidthMbps),
+			VlanID:           intPointer(nic.VlanID),
+			VlanTrunk:        vlanTrunk,
+			SRIOV:            nic.SRIOV.ValueBoolPointer(),
+			VMQ:              nic.VMQ.ValueBoolPointer(),
+			DeviceNaming:     nic.DeviceNaming.ValueBoolPointer(),
+			IPConfigs:        ipConfigSpecs,
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+			return err
+		}
+
+		nic.ID = types.StringValue(jobResult.VMID)
+
+		if ipConfigs != nil {
+			ipConfigList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: IPConfigAttrTypes()}, ipConfigs)
+			if diags.HasError() {
+				return fmt.Errorf("failed to encode ipconfig state: %s", diags)
+			}
+			nic.IPConfig = ipConfigList
+		}
+
+		nics[i] = nic
+	}
+
+	updated, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: NetworkInterfaceAttrTypes()}, nics)
+	if diags.HasError() {
+		return fmt.Errorf("failed to encode network_if state: %s", diags)
+	}
+	data.NetworkInterfaces = updated
+
+	return nil
+}
+
+// resolveIPConfigs reads nic's ipconfig entries, resolving any
+// allocation = "ipam" address that isn't set yet against r.ipam, and
+// returns both the client.NicIPConfigSpec slice to send and the
+// (possibly ipam-resolved) IPConfigModel slice to write back to state.
+// Both are nil if nic has no ipconfig entries.
+func (r *VirtualMachineResource) resolveIPConfigs(ctx context.Context, nic NetworkInterfaceModel) ([]client.NicIPConfigSpec, []IPConfigModel, error) {
+	if nic.IPConfig.IsNull() || nic.IPConfig.IsUnknown() {
+		return nil, nil, nil
+	}
+
+	var ipConfigs []IPConfigModel
+	if diags := nic.IPConfig.ElementsAs(ctx, &ipConfigs, false); diags.HasError() {
+		return nil, nil, fmt.Errorf("invalid ipconfig configuration: %s", diags)
+	}
+
+	specs := make([]client.NicIPConfigSpec, len(ipConfigs))
+
+	for i, ipConfig := range ipConfigs {
+		spec := client.NicIPConfigSpec{
+			Primary:      ipConfig.Primary.ValueBool(),
+			SearchSuffix: ipConfig.SearchSuffix.ValueString(),
+		}
+
+		if !ipConfig.IPv4.IsNull() && !ipConfig.IPv4.IsUnknown() {
+			var ipv4 IPAddressConfigModel
+			if diags := ipConfig.IPv4.As(ctx, &ipv4, basetypes.ObjectAsOptions{}); diags.HasError() {
+				return nil, nil, fmt.Errorf("invalid ipv4 configuration: %s", diags)
+			}
+
+			if ipConfig.Allocation.ValueString() == "ipam" && ipv4.Address.ValueString() == "" {
+				if r.ipam == nil {
+					return nil, nil, fmt.Errorf("ipconfig allocation is \"ipam\" but the provider's ipam block is not configured")
+				}
+
+				address, err := r.ipam.Allocate(ctx, nic.Network.ValueString())
+				if err != nil {
+					return nil, nil, err
+				}
+				ipv4.Address = types.StringValue(address)
+
+				ipv4Obj, diags := types.ObjectValueFrom(ctx, IPAddressConfigAttrTypes(), ipv4)
+				if diags.HasError() {
+					return nil, nil, fmt.Errorf("failed to encode ipv4 state: %s", diags)
+				}
+				ipConfig.IPv4 = ipv4Obj
+				ipConfigs[i] = ipConfig
+			}
+
+			spec.IPv4 = &client.NicIPAddressSpec{
+				Mode:              ipv4.Mode.ValueString(),
+				Address:           ipv4.Address.ValueString(),
+				Gateway:           ipv4.Gateway.ValueString(),
+				PrivacyExtensions: ipv4.PrivacyExtensions.ValueBoolPointer(),
+				AcceptRA:          ipv4.AcceptRA.ValueBoolPointer(),
+			}
+			if !ipv4.DNS.IsNull() && !ipv4.DNS.IsUnknown() {
+				if diags := ipv4.DNS.ElementsAs(ctx, &spec.IPv4.DNS, false); diags.HasError() {
+					return nil, nil, fmt.Errorf("invalid ipv4 dns configuration: %s", diags)
+				}
+			}
+		}
+
+		if !ipConfig.IPv6.IsNull() && !ipConfig.IPv6.IsUnknown() {
+			var ipv6 IPAddressConfigModel
+			if diags := ipConfig.IPv6.As(ctx, &ipv6, basetypes.ObjectAsOptions{}); diags.HasError() {
+				return nil, nil, fmt.Errorf("invalid ipv6 configuration: %s", diags)
+			}
+
+			spec.IPv6 = &client.NicIPAddressSpec{
+				Mode:              ipv6.Mode.ValueString(),
+				Address:           ipv6.Address.ValueString(),
+				Gateway:           ipv6.Gateway.ValueString(),
+				PrivacyExtensions: ipv6.PrivacyExtensions.ValueBoolPointer(),
+				AcceptRA:          ipv6.AcceptRA.ValueBoolPointer(),
+			}
+			if !ipv6.DNS.IsNull() && !ipv6.DNS.IsUnknown() {
+				if diags := ipv6.DNS.ElementsAs(ctx, &spec.IPv6.DNS, false); diags.HasError() {
+					return nil, nil, fmt.Errorf("invalid ipv6 dns configuration: %s", diags)
+				}
+			}
+		}
+
+		specs[i] = spec
+	}
+
+	return specs, ipConfigs, nil
+}
+
+// attachCDROMs creates the CD/DVD drives configured in data.CDROMs and
+// attaches them to the VM in data.ID, sequenced after boot disk creation
+// but before guest initialization so the drives are mounted in time for
+// driver injection during sysprep/cloud-init. It rewrites data.CDROMs in
+// place with the server-assigned IDs.
+func (r *VirtualMachineResource) attachCDROMs(ctx context.Context, data *VirtualMachineResourceModel) error {
+	if data.CDROMs.IsNull() || data.CDROMs.IsUnknown() {
+		return nil
+	}
+
+	var cdroms []CDROMModel
+	if diags := data.CDROMs.ElementsAs(ctx, &cdroms, false); diags.HasError() {
+		return fmt.Errorf("invalid cdrom configuration: %s", diags)
+	}
+
+	for i, cd := range cdroms {
+		jobResult, err := r.client.CreateCDROM(ctx, &client.CDROMSpec{
+			VMID:         data.ID.ValueString(),
+			SourceISO:    cd.SourceISO.ValueString(),
+			StorageClass: cd.StorageClass.ValueString(),
+			EjectOnBoot:  cd.EjectOnBoot.ValueBool(),
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+			return err
+		}
+
+		cdroms[i].ID = types.StringValue(jobResult.VMID)
+	}
+
+	updated, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: CDROMAttrTypes()}, cdroms)
+	if diags.HasError() {
+		return fmt.Errorf("failed to encode cdrom state: %s", diags)
+	}
+	data.CDROMs = updated
+
+	return nil
+}
+
+// cloudInitSSHKeyPlaceholder is a literal token cloud_init.user_data can
+// embed to splice in the resolved ansible.ssh_key (inline or vault-resolved)
+// instead of duplicating the key material in two places.
+const cloudInitSSHKeyPlaceholder = "{{ansible_ssh_key}}"
+
+// renderCloudInitUserData substitutes cloudInitSSHKeyPlaceholder in userData
+// with the resolved ansible SSH key. It is a no-op if the placeholder isn't
+// present, and an error if it is present but no ansible block is configured.
+func (r *VirtualMachineResource) renderCloudInitUserData(ctx context.Context, data *VirtualMachineResourceModel, userData string) (string, error) {
+	if !strings.Contains(userData, cloudInitSSHKeyPlaceholder) {
+		return userData, nil
+	}
+
+	if data.Ansible.IsNull() || data.Ansible.IsUnknown() {
+		return "", fmt.Errorf("cloud_init.user_data references %s but no ansible block is configured", cloudInitSSHKeyPlaceholder)
+	}
+
+	var ansible AnsibleModel
+	if diags := data.Ansible.As(ctx, &ansible, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return "", fmt.Errorf("invalid ansible configuration: %s", diags)
+	}
+
+	sshKey, err := r.resolveSecret(ctx, ansible.SSHKey, ansible.SSHKeyVaultRef)
+	if err != nil {
+		return "", fmt.Errorf("ansible.ssh_key: %w", err)
+	}
+
+	return strings.ReplaceAll(userData, cloudInitSSHKeyPlaceholder, sshKey), nil
+}
+
+// attachCloudInit renders data.CloudInit (if set) into an ISO and attaches
+// it to the VM as a CD/DVD drive: Hyper-V has no native cloud-init
+// datasource, so NoCloud/ConfigDrive/Ignition payloads are delivered this
+// way instead of through the initialize endpoint. It is a no-op if
+// cloud_init was never set.
+func (r *VirtualMachineResource) attachCloudInit(ctx context.Context, data *VirtualMachineResourceModel) error {
+	if data.CloudInit.IsNull() || data.CloudInit.IsUnknown() {
+		return nil
+	}
+
+	var cloudInit CloudInitModel
+	if diags := data.CloudInit.As(ctx, &cloudInit, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return fmt.Errorf("invalid cloud_init configuration: %s", diags)
+	}
+
+	userData, err := r.renderCloudInitUserData(ctx, data, cloudInit.UserData.ValueString())
+	if err != nil {
+		return err
+	}
+
+	jobResult, err := r.client.CreateCloudInitISO(ctx, &client.CloudInitSpec{
+		VMID:          data.ID.ValueString(),
+		UserData:      userData,
+		MetaData:      cloudInit.MetaData.ValueString(),
+		NetworkConfig: cloudInit.NetworkConfig.ValueString(),
+		Datasource:    cloudInit.Datasource.ValueString(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+		return err
+	}
+
+	// jobResult.VMID carries the ID of whatever the job created, reused
+	// here for the rendered ISO; see the same convention in attachCDROMs.
+	iso, err := r.client.GetCloudInitISO(ctx, jobResult.VMID)
+	if err != nil {
+		return err
+	}
+
+	data.CloudInitISOPath = types.StringValue(iso.Path)
+
+	return nil
+}
+
+// createBatched submits spec through r.batch instead of calling
+// r.client.CreateVM directly, and blocks until the coalesced batch (which
+// includes this VM) has been submitted and run to completion server-side.
+// Unlike the unbatched path, there's no intermediate job ID to checkpoint
+// for CreateVM itself: the channel BatchClient.Submit returns doesn't receive
+// until the whole group's job is done, so a crash before this point just means Create is
+// retried from scratch. Disk and network interface creation happen the
+// same way as the unbatched path (they aren't part of what gets batched),
+// checkpointed after each sub-step for the same reason Create is.
+func (r *VirtualMachineResource) createBatched(ctx context.Context, spec *client.VmSpec, data *VirtualMachineResourceModel, resp *resource.CreateResponse) {
+	resultCh, err := r.batch.Submit(ctx, spec)
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Submit Batched Virtual Machine Request", err)
+		return
+	}
+
+	var result client.BatchResult
+	select {
+	case result = <-resultCh:
+	case <-ctx.Done():
+		resp.Diagnostics.AddError("Virtual Machine Provisioning Cancelled", ctx.Err().Error())
+		return
+	}
+
+	if result.Error != "" {
+		resp.Diagnostics.AddError("Virtual Machine Provisioning Failed", result.Error)
+		return
+	}
+
+	data.ID = types.StringValue(result.VMID)
+	data.ProvisioningJobID = types.StringValue("")
+	if result.TargetHost != "" {
+		data.Host = types.StringValue(result.TargetHost)
+	}
+
+	data.Tags = tagging.Apply(ctx, r.client, vmTagResourceType, data.ID.ValueString(), data.Tags, nil, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.createBootDisk(ctx, data); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Create Boot Disk", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.createDisks(ctx, data); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Create Disk", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.createNetworkInterfaces(ctx, data); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Create Network Interface", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// TODO: Apply guest configuration via the initialize endpoint. The
+	// unbatched path's CD-ROM/cloud-init/guest-config steps aren't wired
+	// into the batched path yet either.
 }
 
 func (r *VirtualMachineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -587,9 +2278,56 @@ func (r *VirtualMachineResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	tflog.Debug(ctx, "Reading virtual machine", map[string]interface{}{
-		"id": data.ID.ValueString(),
+		"id":                  data.ID.ValueString(),
+		"provisioning_job_id": data.ProvisioningJobID.ValueString(),
 	})
 
+	if jobID := data.ProvisioningJobID.ValueString(); jobID != "" {
+		// A prior Create/Update was interrupted before its job finished.
+		// Resume polling that job instead of looking the VM up directly,
+		// since the server-side provisioning may still be in progress.
+		job, err := r.client.GetJob(ctx, jobID)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Check In-Flight Provisioning Job",
+				fmt.Sprintf("Could not look up provisioning job %s for virtual machine %s; it will be retried on the next apply. API error: %s", jobID, data.ID.ValueString(), err.Error()),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+
+		switch job.Status {
+		case client.JobStatusCompleted:
+			data.ProvisioningJobID = types.StringValue("")
+		case client.JobStatusFailed:
+			errMsg := "job failed"
+			if job.Error != nil {
+				errMsg = *job.Error
+			}
+			resp.Diagnostics.AddWarning(
+				"Virtual Machine Provisioning Failed",
+				fmt.Sprintf("Provisioning job %s for virtual machine %s failed: %s. Run apply again to retry.", jobID, data.ID.ValueString(), errMsg),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		default:
+			// Still pending/running; leave provisioning_job_id set and
+			// report the VM as-is. The next apply's Create/Update-driven
+			// wait (or another Read) will pick up where this left off.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	if tags, err := r.client.ListResourceTags(ctx, vmTagResourceType, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Refresh Tags",
+			fmt.Sprintf("Could not refresh tags for virtual machine %s; any out-of-band tag changes will not show up as drift this apply. API error: %s", data.ID.ValueString(), err.Error()),
+		)
+	} else {
+		data.Tags = tags
+	}
+
 	// TODO: Implement VM read logic
 	// 1. Get VM by ID
 	// 2. Get associated disks
@@ -610,21 +2348,195 @@ func (r *VirtualMachineResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	var priorData VirtualMachineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Updating virtual machine", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
 
-	// TODO: Implement VM update logic
-	// 1. Compare planned state with current state
-	// 2. Update VM properties (CPU, memory, etc.)
-	// 3. Add/remove/update disks as needed
-	// 4. Add/remove/update network interfaces as needed
-	// 5. Re-apply guest configuration if changed
+	// Tags are reconciled up front, independently of the rest of Update
+	// below, so a failure reconfiguring compute/disks/NICs doesn't leave
+	// tags out of sync with the plan.
+	data.Tags = tagging.Apply(ctx, r.client, vmTagResourceType, data.ID.ValueString(), data.Tags, priorData.Tags, &resp.Diagnostics)
+
+	// NUMA topology is cold-only: Hyper-V will not re-balance a running
+	// VM's virtual processors onto a new node layout. Flag it so the
+	// restart requirement is visible; updateCompute does not send numa
+	// changes to the server.
+	if !data.NUMA.Equal(priorData.NUMA) {
+		resp.Diagnostics.AddWarning(
+			"NUMA Topology Change Requires Restart",
+			"Changing numa requires the virtual machine to be powered off; it will not take effect until the VM's next cold start.",
+		)
+	}
 
-	resp.Diagnostics.AddError(
-		"Not Implemented",
-		"VM update is not yet implemented. This is a scaffold.",
-	)
+	if err := r.updateCompute(ctx, &data, &priorData); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Update Virtual Machine Compute Settings", err)
+		return
+	}
+
+	if err := r.updateDisks(ctx, &data, &priorData); err != nil {
+		resp.Diagnostics.AddError("Unable to Update Virtual Machine Disks", err.Error())
+		return
+	}
+
+	if err := r.updateNetworkInterfaces(&data, &priorData); err != nil {
+		resp.Diagnostics.AddError("Unable to Update Virtual Machine Network Interfaces", err.Error())
+		return
+	}
+
+	if !guestCustomizationEqual(&data, &priorData) {
+		if err := r.applyGuestConfiguration(ctx, &data); err != nil {
+			r.addAPIError(&resp.Diagnostics, "Unable to Apply Guest Configuration", err)
+			return
+		}
+	}
+
+	// TODO: Re-read the VM to pick up server-computed fields once Read's own
+	// TODO (get VM/disks/NICs by ID) is implemented.
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateCompute reconfigures CPU core count and dynamic memory via
+// client.UpdateVM when they've changed, applying only the fields
+// VMUpdateSpec actually supports. cpu.reservation_percent/limit_percent/
+// weight, cpu.compatibility_mode, dynamic_memory.memory_prcnt_buffer/
+// memory_priority/memory_weight, and numa have no field in
+// client.VMUpdateSpec and are not sent.
+func (r *VirtualMachineResource) updateCompute(ctx context.Context, data, priorData *VirtualMachineResourceModel) error {
+	spec := &client.VMUpdateSpec{}
+	changed := false
+
+	if !data.CPUCores.Equal(priorData.CPUCores) {
+		spec.CPUCores = int(data.CPUCores.ValueInt64())
+		changed = true
+	}
+
+	if !data.StartupMemoryGB.Equal(priorData.StartupMemoryGB) {
+		v := data.StartupMemoryGB.ValueFloat64()
+		spec.MemoryStartupGB = &v
+		changed = true
+	}
+
+	if !data.DynamicMemory.Equal(priorData.DynamicMemory) {
+		changed = true
+		if data.DynamicMemory.IsNull() {
+			disabled := false
+			spec.DynamicMemoryEnabled = &disabled
+		} else {
+			var dm DynamicMemoryModel
+			if diags := data.DynamicMemory.As(ctx, &dm, basetypes.ObjectAsOptions{}); diags.HasError() {
+				return fmt.Errorf("invalid dynamic_memory configuration: %s", diags)
+			}
+			enabled := true
+			min := dm.MemoryGBMin.ValueFloat64()
+			max := dm.MemoryGBMax.ValueFloat64()
+			spec.DynamicMemoryEnabled = &enabled
+			spec.MemoryMinGB = &min
+			spec.MemoryMaxGB = &max
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	jobResult, err := r.client.UpdateVM(ctx, data.ID.ValueString(), spec)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{})
+	return err
+}
+
+// updateDisks resizes disks whose size_gb changed, since ResizeDisk is the
+// only in-place disk update the client exposes. Adding, removing, or
+// changing any other disk attribute (storage_class, controller placement,
+// provisioning, QoS) isn't supported yet, so those return a descriptive
+// error instead of being silently dropped.
+func (r *VirtualMachineResource) updateDisks(ctx context.Context, data, priorData *VirtualMachineResourceModel) error {
+	if data.Disks.Equal(priorData.Disks) {
+		return nil
+	}
+
+	var planned, prior []DiskModel
+	if diags := data.Disks.ElementsAs(ctx, &planned, false); diags.HasError() {
+		return fmt.Errorf("invalid disk configuration: %s", diags)
+	}
+	if diags := priorData.Disks.ElementsAs(ctx, &prior, false); diags.HasError() {
+		return fmt.Errorf("invalid prior disk state: %s", diags)
+	}
+
+	if len(planned) != len(prior) {
+		return fmt.Errorf("adding or removing disk blocks during update is not yet supported; destroy and recreate the virtual machine instead")
+	}
+
+	priorByID := make(map[string]DiskModel, len(prior))
+	for _, d := range prior {
+		priorByID[d.ID.ValueString()] = d
+	}
+
+	for _, d := range planned {
+		priorDisk, ok := priorByID[d.ID.ValueString()]
+		if !ok {
+			return fmt.Errorf("adding or removing disk blocks during update is not yet supported; destroy and recreate the virtual machine instead")
+		}
+
+		if !d.StorageClass.Equal(priorDisk.StorageClass) ||
+			!d.ControllerType.Equal(priorDisk.ControllerType) ||
+			!d.ControllerNumber.Equal(priorDisk.ControllerNumber) ||
+			!d.ControllerLocation.Equal(priorDisk.ControllerLocation) ||
+			!d.Provisioning.Equal(priorDisk.Provisioning) ||
+			!d.MaxIOPS.Equal(priorDisk.MaxIOPS) ||
+			!d.MinIOPS.Equal(priorDisk.MinIOPS) {
+			return fmt.Errorf("disk %s: only size_gb can be changed in place; storage_class/controller_type/controller_number/controller_location/provisioning/max_iops/min_iops require destroying and recreating the disk", d.ID.ValueString())
+		}
+
+		if d.SizeGB.Equal(priorDisk.SizeGB) {
+			continue
+		}
+
+		jobResult, err := r.client.ResizeDisk(ctx, d.ID.ValueString(), int(d.SizeGB.ValueFloat64()))
+		if err != nil {
+			return err
+		}
+		if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateNetworkInterfaces errors if any network_if block changed: the
+// client only exposes CreateNIC/DeleteNIC, with no field-level NIC
+// reconfigure endpoint, so there's no in-place update path to apply here
+// yet.
+func (r *VirtualMachineResource) updateNetworkInterfaces(data, priorData *VirtualMachineResourceModel) error {
+	if data.NetworkInterfaces.Equal(priorData.NetworkInterfaces) {
+		return nil
+	}
+	return fmt.Errorf("changing network_if blocks during update is not yet supported; destroy and recreate the virtual machine instead")
+}
+
+// guestCustomizationEqual reports whether none of the guest-specialization
+// blocks changed between prior and planned state, so Update only re-applies
+// guest configuration (which isn't idempotent-free - it's another sysprep/
+// cloud-init run against a live guest) when something actually changed.
+func guestCustomizationEqual(data, priorData *VirtualMachineResourceModel) bool {
+	return data.SpecializationFamily.Equal(priorData.SpecializationFamily) &&
+		data.User.Equal(priorData.User) &&
+		data.DomainJoin.Equal(priorData.DomainJoin) &&
+		data.Ansible.Equal(priorData.Ansible) &&
+		data.Windows.Equal(priorData.Windows) &&
+		data.Linux.Equal(priorData.Linux)
 }
 
 func (r *VirtualMachineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -641,14 +2553,98 @@ func (r *VirtualMachineResource) Delete(ctx context.Context, req resource.Delete
 		"id": data.ID.ValueString(),
 	})
 
-	// TODO: Implement VM deletion logic
-	// 1. Delete VM (which should cascade to disks and NICs)
-	// 2. Wait for job completion
+	checkpoints, err := r.client.ListCheckpoints(ctx, data.ID.ValueString())
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to List Virtual Machine Checkpoints", err)
+		return
+	}
+
+	if len(checkpoints) > 0 {
+		if !data.ForceDeleteCheckpoints.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Virtual Machine Has Checkpoints",
+				fmt.Sprintf("%s has %d checkpoint(s); destroy the aetherv_virtual_machine_checkpoint resources managing them first, or set force_delete_checkpoints = true to have this delete remove them.", data.ID.ValueString(), len(checkpoints)),
+			)
+			return
+		}
+
+		for _, cp := range checkpoints {
+			jobResult, err := r.client.DeleteCheckpoint(ctx, cp.ID)
+			if err != nil {
+				r.addAPIError(&resp.Diagnostics, "Unable to Delete Virtual Machine Checkpoint", err)
+				return
+			}
+			if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+				r.addAPIError(&resp.Diagnostics, "Virtual Machine Checkpoint Deletion Failed", err)
+				return
+			}
+		}
+	}
 
-	resp.Diagnostics.AddError(
-		"Not Implemented",
-		"VM deletion is not yet implemented. This is a scaffold.",
-	)
+	jobResult, err := r.client.DeleteVM(ctx, data.ID.ValueString())
+	if err != nil {
+		r.addAPIError(&resp.Diagnostics, "Unable to Delete Virtual Machine", err)
+		return
+	}
+	if _, err := r.client.WaitForJobWithOptions(ctx, jobResult.JobID, client.WaitForJobOptions{}); err != nil {
+		r.addAPIError(&resp.Diagnostics, "Virtual Machine Deletion Failed", err)
+		return
+	}
+
+	// IPAM addresses are only released once the VM delete above has actually
+	// succeeded: releasing them first would let another VM claim the address
+	// while this one still held it, if the delete itself then failed.
+	if r.ipam != nil {
+		if err := r.releaseIPAMAddresses(ctx, &data); err != nil {
+			r.addAPIError(&resp.Diagnostics, "Unable to Release IPAM Address", err)
+			return
+		}
+	}
+}
+
+// releaseIPAMAddresses returns every ipconfig entry with allocation = "ipam"
+// to the ipam backend, so destroying a VM doesn't leak addresses from the
+// pool.
+func (r *VirtualMachineResource) releaseIPAMAddresses(ctx context.Context, data *VirtualMachineResourceModel) error {
+	if data.NetworkInterfaces.IsNull() || data.NetworkInterfaces.IsUnknown() {
+		return nil
+	}
+
+	var nics []NetworkInterfaceModel
+	if diags := data.NetworkInterfaces.ElementsAs(ctx, &nics, false); diags.HasError() {
+		return fmt.Errorf("failed to read network interfaces: %s", diags)
+	}
+
+	for _, nic := range nics {
+		if nic.IPConfig.IsNull() || nic.IPConfig.IsUnknown() {
+			continue
+		}
+
+		var ipConfigs []IPConfigModel
+		if diags := nic.IPConfig.ElementsAs(ctx, &ipConfigs, false); diags.HasError() {
+			return fmt.Errorf("failed to read ipconfig entries: %s", diags)
+		}
+
+		for _, ipConfig := range ipConfigs {
+			if ipConfig.Allocation.ValueString() != "ipam" || ipConfig.IPv4.IsNull() || ipConfig.IPv4.IsUnknown() {
+				continue
+			}
+
+			var ipv4 IPAddressConfigModel
+			if diags := ipConfig.IPv4.As(ctx, &ipv4, basetypes.ObjectAsOptions{}); diags.HasError() {
+				return fmt.Errorf("failed to read ipv4 configuration: %s", diags)
+			}
+			if ipv4.Address.IsNull() || ipv4.Address.ValueString() == "" {
+				continue
+			}
+
+			if err := r.ipam.Release(ctx, ipv4.Address.ValueString()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
 func (r *VirtualMachineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -661,6 +2657,26 @@ func DynamicMemoryAttrTypes() map[string]attr.Type {
 		"memory_gb_min":       types.Float64Type,
 		"memory_gb_max":       types.Float64Type,
 		"memory_prcnt_buffer": types.Int64Type,
+		"memory_priority":     types.Int64Type,
+		"memory_weight":       types.Int64Type,
+	}
+}
+
+func CPUAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"reservation_percent": types.Int64Type,
+		"limit_percent":       types.Int64Type,
+		"weight":              types.Int64Type,
+		"compatibility_mode":  types.BoolType,
+	}
+}
+
+func NUMAAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"processors_per_node": types.Int64Type,
+		"memory_per_node_gb":  types.Float64Type,
+		"nodes_per_socket":    types.Int64Type,
+		"spanning_enabled":    types.BoolType,
 	}
 }
 
@@ -670,39 +2686,78 @@ func TPMAttrTypes() map[string]attr.Type {
 	}
 }
 
-func BootDiskAttrTypes() map[string]attr.Type {
+// diskPlacementAttrTypes returns the attr.Type equivalents of
+// diskPlacementAttributes(), shared by BootDiskAttrTypes() and
+// DiskAttrTypes().
+func diskPlacementAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
+		"controller_type":     types.StringType,
+		"controller_number":   types.Int64Type,
+		"controller_location": types.Int64Type,
+		"provisioning":        types.StringType,
+		"max_iops":            types.Int64Type,
+		"min_iops":            types.Int64Type,
+	}
+}
+
+func BootDiskAttrTypes() map[string]attr.Type {
+	t := map[string]attr.Type{
 		"id":            types.StringType,
 		"source_image":  types.StringType,
 		"expand_to_gb":  types.Float64Type,
 		"storage_class": types.StringType,
 	}
+	for k, v := range diskPlacementAttrTypes() {
+		t[k] = v
+	}
+	return t
 }
 
 func DiskAttrTypes() map[string]attr.Type {
-	return map[string]attr.Type{
+	t := map[string]attr.Type{
 		"id":            types.StringType,
 		"size_gb":       types.Float64Type,
 		"storage_class": types.StringType,
 	}
+	for k, v := range diskPlacementAttrTypes() {
+		t[k] = v
+	}
+	return t
+}
+
+func CDROMAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":            types.StringType,
+		"source_iso":    types.StringType,
+		"storage_class": types.StringType,
+		"eject_on_boot": types.BoolType,
+	}
 }
 
 func NetworkInterfaceAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"id":                types.StringType,
-		"network":           types.StringType,
-		"dhcp_guard":        types.BoolType,
-		"router_guard":      types.BoolType,
-		"mac_spoof_guard":   types.BoolType,
-		"mac_address":       types.StringType,
+		"id":                 types.StringType,
+		"network":            types.StringType,
+		"adapter_type":       types.StringType,
+		"dhcp_guard":         types.BoolType,
+		"router_guard":       types.BoolType,
+		"mac_spoof_guard":    types.BoolType,
+		"mac_address":        types.StringType,
 		"min_bandwidth_mbps": types.Int64Type,
 		"max_bandwidth_mbps": types.Int64Type,
-		"ipconfig":          types.ObjectType{AttrTypes: IPConfigAttrTypes()},
+		"vlan_id":            types.Int64Type,
+		"vlan_trunk":         types.ListType{ElemType: types.Int64Type},
+		"sr_iov":             types.BoolType,
+		"vmq":                types.BoolType,
+		"device_naming":      types.BoolType,
+		"ipconfig":           types.ListType{ElemType: types.ObjectType{AttrTypes: IPConfigAttrTypes()}},
 	}
 }
 
 func IPConfigAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
+		"primary":       types.BoolType,
+		"allocation":    types.StringType,
 		"ipv4":          types.ObjectType{AttrTypes: IPAddressConfigAttrTypes()},
 		"ipv6":          types.ObjectType{AttrTypes: IPAddressConfigAttrTypes()},
 		"search_suffix": types.StringType,
@@ -711,16 +2766,20 @@ func IPConfigAttrTypes() map[string]attr.Type {
 
 func IPAddressConfigAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"address": types.StringType,
-		"gateway": types.StringType,
-		"dns":     types.ListType{ElemType: types.StringType},
+		"mode":               types.StringType,
+		"address":            types.StringType,
+		"gateway":            types.StringType,
+		"dns":                types.ListType{ElemType: types.StringType},
+		"privacy_extensions": types.BoolType,
+		"accept_ra":          types.BoolType,
 	}
 }
 
 func UserAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"username": types.StringType,
-		"password": types.StringType,
+		"username":           types.StringType,
+		"password":           types.StringType,
+		"password_vault_ref": types.ObjectType{AttrTypes: VaultRefAttrTypes()},
 	}
 }
 
@@ -730,13 +2789,51 @@ func DomainJoinAttrTypes() map[string]attr.Type {
 		"organizational_unit": types.StringType,
 		"username":            types.StringType,
 		"password":            types.StringType,
+		"password_vault_ref":  types.ObjectType{AttrTypes: VaultRefAttrTypes()},
 	}
 }
 
 func AnsibleAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"username": types.StringType,
-		"ssh_key":  types.StringType,
+		"username":          types.StringType,
+		"ssh_key":           types.StringType,
+		"ssh_key_vault_ref": types.ObjectType{AttrTypes: VaultRefAttrTypes()},
+	}
+}
+
+func VaultRefAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"path":  types.StringType,
+		"field": types.StringType,
+	}
+}
+
+func WindowsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"product_key":          types.StringType,
+		"timezone":             types.StringType,
+		"locale":               types.StringType,
+		"computer_name":        types.StringType,
+		"auto_logon_count":     types.Int64Type,
+		"first_logon_commands": types.ListType{ElemType: types.StringType},
+	}
+}
+
+func LinuxAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"hostname": types.StringType,
+		"timezone": types.StringType,
+		"locale":   types.StringType,
+		"run_cmd":  types.ListType{ElemType: types.StringType},
+	}
+}
+
+func CloudInitAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"user_data":      types.StringType,
+		"meta_data":      types.StringType,
+		"network_config": types.StringType,
+		"datasource":     types.StringType,
 	}
 }
 
@@ -0,0 +1,224 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/aetherv/aether-v/terraform/internal/testing/testclient"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// newVMCreateRequest builds a resource.CreateRequest/CreateResponse pair for
+// r, bypassing the framework's normal RPC dispatch so Create can be
+// exercised directly against a fake client.
+func newVMCreateRequest(t *testing.T, r *VirtualMachineResource, plan VirtualMachineResourceModel) (resource.CreateRequest, *resource.CreateResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("building schema: %s", schemaResp.Diagnostics)
+	}
+
+	planState := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := planState.Set(ctx, &plan); diags.HasError() {
+		t.Fatalf("building plan: %s", diags)
+	}
+
+	req := resource.CreateRequest{Plan: planState}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	return req, resp
+}
+
+// minimalVMPlan returns the smallest valid VirtualMachineResourceModel for
+// name: a boot disk, one extra disk, and one network interface, with every
+// other block left unset. Computed attributes are Unknown, matching what a
+// real "terraform plan" for a brand-new resource would hand to Create.
+func minimalVMPlan(ctx context.Context, t *testing.T, name string) VirtualMachineResourceModel {
+	t.Helper()
+
+	bootDisk, diags := types.ObjectValueFrom(ctx, BootDiskAttrTypes(), BootDiskModel{
+		ID:                 types.StringUnknown(),
+		SourceImage:        types.StringValue("ubuntu-22.04"),
+		ExpandToGB:         types.Float64Null(),
+		StorageClass:       types.StringNull(),
+		ControllerType:     types.StringNull(),
+		ControllerNumber:   types.Int64Null(),
+		ControllerLocation: types.Int64Null(),
+		Provisioning:       types.StringNull(),
+		MaxIOPS:            types.Int64Null(),
+		MinIOPS:            types.Int64Null(),
+	})
+	if diags.HasError() {
+		t.Fatalf("building boot_disk: %s", diags)
+	}
+
+	disks, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: DiskAttrTypes()}, []DiskModel{
+		{
+			ID:                 types.StringUnknown(),
+			SizeGB:             types.Float64Value(50),
+			StorageClass:       types.StringNull(),
+			ControllerType:     types.StringNull(),
+			ControllerNumber:   types.Int64Null(),
+			ControllerLocation: types.Int64Null(),
+			Provisioning:       types.StringNull(),
+			MaxIOPS:            types.Int64Null(),
+			MinIOPS:            types.Int64Null(),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("building disk: %s", diags)
+	}
+
+	nics, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: NetworkInterfaceAttrTypes()}, []NetworkInterfaceModel{
+		{
+			ID:               types.StringUnknown(),
+			Network:          types.StringValue("lan"),
+			AdapterType:      types.StringNull(),
+			DHCPGuard:        types.BoolNull(),
+			RouterGuard:      types.BoolNull(),
+			MACSpoofGuard:    types.BoolNull(),
+			MACAddress:       types.StringNull(),
+			MinBandwidthMbps: types.Int64Null(),
+			MaxBandwidthMbps: types.Int64Null(),
+			VlanID:           types.Int64Null(),
+			VlanTrunk:        types.ListNull(types.Int64Type),
+			SRIOV:            types.BoolNull(),
+			VMQ:              types.BoolNull(),
+			DeviceNaming:     types.BoolNull(),
+			IPConfig:         types.ListNull(types.ObjectType{AttrTypes: IPConfigAttrTypes()}),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("building network_if: %s", diags)
+	}
+
+	return VirtualMachineResourceModel{
+		ID:                          types.StringUnknown(),
+		Name:                        types.StringValue(name),
+		Host:                        types.StringValue("host1"),
+		Cluster:                     types.StringNull(),
+		ProvisioningJobID:           types.StringUnknown(),
+		CPUCores:                    types.Int64Value(2),
+		CPU:                         types.ObjectNull(CPUAttrTypes()),
+		StartupMemoryGB:             types.Float64Value(4),
+		DynamicMemory:               types.ObjectNull(DynamicMemoryAttrTypes()),
+		NUMA:                        types.ObjectNull(NUMAAttrTypes()),
+		SecureBoot:                  types.StringNull(),
+		TrustedPlatformModule:       types.ObjectNull(TPMAttrTypes()),
+		BootDisk:                    bootDisk,
+		Disks:                       disks,
+		CDROMs:                      types.ListNull(types.ObjectType{AttrTypes: CDROMAttrTypes()}),
+		NetworkInterfaces:           nics,
+		SpecializationFamily:        types.StringNull(),
+		User:                        types.ObjectNull(UserAttrTypes()),
+		DomainJoin:                  types.ObjectNull(DomainJoinAttrTypes()),
+		Ansible:                     types.ObjectNull(AnsibleAttrTypes()),
+		Windows:                     types.ObjectNull(WindowsAttrTypes()),
+		Linux:                       types.ObjectNull(LinuxAttrTypes()),
+		CloudInit:                   types.ObjectNull(CloudInitAttrTypes()),
+		CloudInitISOPath:            types.StringUnknown(),
+		HostRecoveryAction:          types.StringUnknown(),
+		HostStopAction:              types.StringUnknown(),
+		IntegrationSvcs:             types.ObjectNull(IntegrationServicesAttrTypes()),
+		CheckpointType:              types.StringUnknown(),
+		AutomaticCheckpointsEnabled: types.BoolUnknown(),
+		ForceDeleteCheckpoints:      types.BoolUnknown(),
+		Tags:                        nil,
+	}
+}
+
+// TestVirtualMachineResourceCreate_ProvisionsDisksAndNetworkInterfaces
+// guards against Create silently skipping boot_disk/disk/network_if
+// provisioning: each of those blocks has a Computed id that Terraform's
+// post-apply consistency check requires Create to resolve, so a regression
+// here would surface as a confusing "inconsistent result after apply" error
+// instead of a clear test failure.
+func TestVirtualMachineResourceCreate_ProvisionsDisksAndNetworkInterfaces(t *testing.T) {
+	ctx := context.Background()
+
+	var createDiskCalls []*client.DiskSpec
+	var createNICCalls []*client.NicSpec
+
+	fake := &testclient.FakeClient{
+		CreateVMFunc: func(ctx context.Context, spec *client.VmSpec) (*client.JobResult, error) {
+			return &client.JobResult{JobID: "job-vm", VMID: "vm-1"}, nil
+		},
+		CreateDiskFunc: func(ctx context.Context, spec *client.DiskSpec) (*client.JobResult, error) {
+			createDiskCalls = append(createDiskCalls, spec)
+			return &client.JobResult{JobID: "job-disk", VMID: "disk-" + spec.DiskType + "x"}, nil
+		},
+		CreateNICFunc: func(ctx context.Context, spec *client.NicSpec) (*client.JobResult, error) {
+			createNICCalls = append(createNICCalls, spec)
+			return &client.JobResult{JobID: "job-nic", VMID: "nic-1"}, nil
+		},
+		WaitForJobWithOptionsFunc: func(ctx context.Context, jobID string, opts client.WaitForJobOptions) (*client.Job, error) {
+			return &client.Job{JobID: jobID, Status: client.JobStatus("completed")}, nil
+		},
+	}
+
+	r := &VirtualMachineResource{client: fake}
+
+	req, resp := newVMCreateRequest(t, r, minimalVMPlan(ctx, t, "test-vm"))
+	r.Create(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+
+	if len(createDiskCalls) != 2 {
+		t.Fatalf("expected CreateDisk to be called twice (boot disk + extra disk), got %d", len(createDiskCalls))
+	}
+	if createDiskCalls[0].ImageName != "ubuntu-22.04" {
+		t.Fatalf("expected boot disk's source image to reach CreateDisk, got %q", createDiskCalls[0].ImageName)
+	}
+	if createDiskCalls[1].DiskSizeGB != 50 {
+		t.Fatalf("expected extra disk's size to reach CreateDisk, got %d", createDiskCalls[1].DiskSizeGB)
+	}
+
+	if len(createNICCalls) != 1 {
+		t.Fatalf("expected CreateNIC to be called once, got %d", len(createNICCalls))
+	}
+	if createNICCalls[0].Network != "lan" {
+		t.Fatalf("expected network_if.network to reach CreateNIC, got %q", createNICCalls[0].Network)
+	}
+
+	var out VirtualMachineResourceModel
+	if diags := resp.State.Get(ctx, &out); diags.HasError() {
+		t.Fatalf("reading result state: %s", diags)
+	}
+
+	var bootDisk BootDiskModel
+	if diags := out.BootDisk.As(ctx, &bootDisk, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("reading boot_disk state: %s", diags)
+	}
+	if bootDisk.ID.IsNull() || bootDisk.ID.IsUnknown() || bootDisk.ID.ValueString() == "" {
+		t.Fatal("expected boot_disk.id to be resolved by Create, got null/unknown/empty")
+	}
+
+	var disks []DiskModel
+	if diags := out.Disks.ElementsAs(ctx, &disks, false); diags.HasError() {
+		t.Fatalf("reading disk state: %s", diags)
+	}
+	if len(disks) != 1 || disks[0].ID.ValueString() == "" {
+		t.Fatalf("expected disk[0].id to be resolved by Create, got %+v", disks)
+	}
+
+	var nics []NetworkInterfaceModel
+	if diags := out.NetworkInterfaces.ElementsAs(ctx, &nics, false); diags.HasError() {
+		t.Fatalf("reading network_if state: %s", diags)
+	}
+	if len(nics) != 1 || nics[0].ID.ValueString() == "" {
+		t.Fatalf("expected network_if[0].id to be resolved by Create, got %+v", nics)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+	"github.com/aetherv/aether-v/terraform/internal/testing/testprovider"
+)
+
+// testAccPreCheck skips acceptance tests unless they're explicitly pointed
+// at a live Aether-V server; resource.Test already gates on TF_ACC, this
+// only covers the backend-specific prerequisites.
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("AETHERV_ENDPOINT") == "" {
+		t.Skip("AETHERV_ENDPOINT must be set for acceptance tests")
+	}
+}
+
+// TestAccVirtualMachineResource_TagDrift verifies that tags changed
+// out-of-band (not through Terraform) are detected as drift on the next
+// Read, exercising the refresh added to VirtualMachineResource.Read by the
+// tagging package.
+func TestAccVirtualMachineResource_TagDrift(t *testing.T) {
+	resourceName := "aetherv_virtual_machine.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testprovider.ProtocolV6ProviderFactories("test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualMachineConfigTags("env", "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "test"),
+				),
+			},
+			{
+				// Change the tag directly against the API, bypassing
+				// Terraform entirely. RefreshOnly re-runs Read without
+				// reapplying config, so this plan only passes if Read
+				// picked up the out-of-band value instead of trusting
+				// stale state.
+				PreConfig: func() {
+					mutateVMTagOutOfBand(t, resourceName, "env", "prod")
+				},
+				Config:             testAccVirtualMachineConfigTags("env", "test"),
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// mutateVMTagOutOfBand writes a tag directly through the client, the way an
+// operator editing tags outside Terraform would, so the next Read has to
+// surface it as drift rather than something Terraform itself changed.
+func mutateVMTagOutOfBand(t *testing.T, resourceName, key, value string) {
+	t.Helper()
+
+	ctx := context.Background()
+	c, err := client.NewClient(ctx, os.Getenv("AETHERV_ENDPOINT"), client.AuthConfig{Mode: client.AuthModeAzureCLI})
+	if err != nil {
+		t.Fatalf("failed to build client for out-of-band tag mutation: %s", err)
+	}
+
+	// The VM ID isn't known until the prior step applies; instead of
+	// threading state through the test helper, it is taken from
+	// AETHERV_TEST_VM_ID, and the test is skipped when that is unset.
+	vmID := os.Getenv("AETHERV_TEST_VM_ID")
+	if vmID == "" {
+		t.Skip("AETHERV_TEST_VM_ID must be set to the VM created by the prior step")
+	}
+
+	if _, err := c.UpdateResourceTags(ctx, "vms", vmID, map[string]string{key: value}, nil); err != nil {
+		t.Fatalf("failed to mutate tag out-of-band: %s", err)
+	}
+}
+
+func testAccVirtualMachineConfigTags(tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aetherv_virtual_machine" "test" {
+  name              = "tf-acc-test-tags"
+  host              = %q
+  cpu_cores         = 1
+  startup_memory_gb = 1
+
+  boot_disk = {
+    source_image = "acc-test-image"
+  }
+
+  tags = {
+    %s = %q
+  }
+}
+`, os.Getenv("AETHERV_TEST_HOST"), tagKey, tagValue)
+}
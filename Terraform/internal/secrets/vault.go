@@ -0,0 +1,323 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+// Package secrets resolves VM guest-customization credentials (local user
+// passwords, domain join passwords, Ansible SSH keys) from HashiCorp Vault
+// instead of requiring them inline in Terraform configuration and state.
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuthMode selects how Client authenticates to Vault.
+type AuthMode string
+
+const (
+	// AuthModeToken uses Config.Token directly, with no login call.
+	AuthModeToken AuthMode = "token"
+
+	// AuthModeAppRole exchanges Config.RoleID/Config.SecretID for a token
+	// via the approle auth method.
+	AuthModeAppRole AuthMode = "approle"
+
+	// AuthModeKubernetes exchanges the pod's projected service account
+	// token for a Vault token via the kubernetes auth method.
+	AuthModeKubernetes AuthMode = "kubernetes"
+)
+
+// defaultKubernetesTokenPath is where Kubernetes projects a pod's service
+// account token by default.
+const defaultKubernetesTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// Config describes how to reach and authenticate to a Vault server. Only
+// the fields relevant to Mode need to be populated.
+type Config struct {
+	// Address is the Vault server's base URL, e.g. "https://vault.example.com:8200".
+	Address string
+
+	// Namespace selects a Vault Enterprise namespace. Left empty on Vault
+	// Community Edition or when using the root namespace.
+	Namespace string
+
+	Mode AuthMode
+
+	// Token is used directly when Mode is AuthModeToken.
+	Token string
+
+	// RoleID and SecretID are used when Mode is AuthModeAppRole.
+	RoleID   string
+	SecretID string
+
+	// KubernetesRole is the Vault role to authenticate as when Mode is
+	// AuthModeKubernetes.
+	KubernetesRole string
+
+	// KubernetesMountPath overrides the kubernetes auth method's mount
+	// path. Defaults to "kubernetes".
+	KubernetesMountPath string
+
+	// KubernetesTokenPath overrides where the pod's service account token
+	// is read from. Defaults to defaultKubernetesTokenPath.
+	KubernetesTokenPath string
+}
+
+func (c Config) address() string {
+	return strings.TrimRight(c.Address, "/")
+}
+
+func (c Config) kubernetesMountPath() string {
+	if c.KubernetesMountPath != "" {
+		return c.KubernetesMountPath
+	}
+	return "kubernetes"
+}
+
+func (c Config) kubernetesTokenPath() string {
+	if c.KubernetesTokenPath != "" {
+		return c.KubernetesTokenPath
+	}
+	return defaultKubernetesTokenPath
+}
+
+// Client resolves secret references (a Vault path plus a field within it)
+// against a Vault server, reauthenticating as its token nears expiry.
+type Client struct {
+	config     Config
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	token     string
+	renewable bool
+	expiresAt time.Time
+}
+
+// NewClient creates a Client and, unless Mode is AuthModeToken, performs an
+// initial login so configuration errors surface at provider Configure time
+// rather than on the first guest-customization apply.
+func NewClient(ctx context.Context, cfg Config) (*Client, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("vault address is required")
+	}
+
+	c := &Client{
+		config:     cfg,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+
+	if cfg.Mode == AuthModeToken {
+		if cfg.Token == "" {
+			return nil, fmt.Errorf("auth_mode = \"token\" requires a token")
+		}
+		c.token = cfg.Token
+		return c, nil
+	}
+
+	if err := c.login(ctx); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Resolve fetches field from the secret stored at path (e.g. a KV v2 data
+// path like "kv/data/vms/web01") and returns its string value.
+func (c *Client) Resolve(ctx context.Context, path, field string) (string, error) {
+	token, err := c.authToken(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to authenticate to vault: %w", err)
+	}
+
+	body, err := c.do(ctx, http.MethodGet, path, token, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to read vault secret %q: %w", path, err)
+	}
+
+	var parsed struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse vault response for %q: %w", path, err)
+	}
+
+	data := parsed.Data
+	// KV v2 nests the actual secret under an inner "data" key alongside
+	// "metadata"; KV v1 returns the secret directly.
+	if inner, ok := data["data"].(map[string]interface{}); ok {
+		data = inner
+	}
+
+	value, ok := data[field]
+	if !ok {
+		return "", fmt.Errorf("vault secret %q has no field %q", path, field)
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("vault secret %q field %q is not a string", path, field)
+	}
+
+	return str, nil
+}
+
+// authToken returns the current token, reauthenticating first if it's
+// within a minute of expiring. AuthModeToken tokens never expire from the
+// client's perspective, so this is a no-op for that mode.
+func (c *Client) authToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	needsLogin := c.config.Mode != AuthModeToken && (c.token == "" || time.Now().After(c.expiresAt.Add(-time.Minute)))
+	c.mu.Unlock()
+
+	if needsLogin {
+		if err := c.login(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.token, nil
+}
+
+// login authenticates to Vault per c.config.Mode and caches the resulting
+// token and its lease duration.
+func (c *Client) login(ctx context.Context) error {
+	switch c.config.Mode {
+	case "", AuthModeToken:
+		c.mu.Lock()
+		c.token = c.config.Token
+		c.mu.Unlock()
+		return nil
+
+	case AuthModeAppRole:
+		return c.loginAppRole(ctx)
+
+	case AuthModeKubernetes:
+		return c.loginKubernetes(ctx)
+
+	default:
+		return fmt.Errorf("unknown vault auth_mode %q", c.config.Mode)
+	}
+}
+
+func (c *Client) loginAppRole(ctx context.Context) error {
+	reqBody, err := json.Marshal(map[string]string{
+		"role_id":   c.config.RoleID,
+		"secret_id": c.config.SecretID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal approle login request: %w", err)
+	}
+
+	return c.loginAuthPath(ctx, "/v1/auth/approle/login", reqBody)
+}
+
+func (c *Client) loginKubernetes(ctx context.Context) error {
+	jwt, err := os.ReadFile(c.config.kubernetesTokenPath())
+	if err != nil {
+		return fmt.Errorf("failed to read kubernetes service account token %s: %w", c.config.kubernetesTokenPath(), err)
+	}
+
+	reqBody, err := json.Marshal(map[string]string{
+		"role": c.config.KubernetesRole,
+		"jwt":  strings.TrimSpace(string(jwt)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal kubernetes login request: %w", err)
+	}
+
+	return c.loginAuthPath(ctx, fmt.Sprintf("/v1/auth/%s/login", c.config.kubernetesMountPath()), reqBody)
+}
+
+// loginAuthPath POSTs to a Vault auth method's login endpoint and caches
+// the returned client token.
+func (c *Client) loginAuthPath(ctx context.Context, authPath string, reqBody []byte) error {
+	body, err := c.do(ctx, http.MethodPost, authPath, "", bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("vault login failed: %w", err)
+	}
+
+	var parsed struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int    `json:"lease_duration"`
+			Renewable     bool   `json:"renewable"`
+		} `json:"auth"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return fmt.Errorf("failed to parse vault login response: %w", err)
+	}
+	if parsed.Auth.ClientToken == "" {
+		return fmt.Errorf("vault login response did not include a client token")
+	}
+
+	c.mu.Lock()
+	c.token = parsed.Auth.ClientToken
+	c.renewable = parsed.Auth.Renewable
+	c.expiresAt = time.Now().Add(time.Duration(parsed.Auth.LeaseDuration) * time.Second)
+	c.mu.Unlock()
+
+	return nil
+}
+
+// do performs a raw Vault API request. token, if non-empty, is sent as
+// X-Vault-Token; authPath/secret requests set it, login requests don't
+// have one yet.
+func (c *Client) do(ctx context.Context, method, vaultPath, token string, body *bytes.Reader) ([]byte, error) {
+	reqURL := fmt.Sprintf("%s%s", c.config.address(), normalizeVaultPath(vaultPath))
+
+	var reqBody *bytes.Reader
+	if body != nil {
+		reqBody = body
+	} else {
+		reqBody = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if c.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.config.Namespace)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach vault at %s: %w", c.config.address(), err)
+	}
+	defer resp.Body.Close()
+
+	respBody := new(bytes.Buffer)
+	if _, err := respBody.ReadFrom(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to read vault response body: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("vault request to %s failed with status %d: %s", vaultPath, resp.StatusCode, respBody.String())
+	}
+
+	return respBody.Bytes(), nil
+}
+
+// normalizeVaultPath prefixes a bare secret engine path (e.g.
+// "kv/data/vms/web01") with "/v1/" so callers of Resolve don't need to know
+// Vault's HTTP API layout, while leaving already-prefixed paths (the
+// "/v1/auth/..." login endpoints) untouched.
+func normalizeVaultPath(vaultPath string) string {
+	if strings.HasPrefix(vaultPath, "/v1/") {
+		return vaultPath
+	}
+	return "/v1/" + strings.TrimLeft(vaultPath, "/")
+}
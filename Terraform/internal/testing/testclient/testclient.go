@@ -0,0 +1,198 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+// Package testclient provides a fake client.Client implementation for
+// exercising provider and data source logic without a live Aether-V server.
+package testclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aetherv/aether-v/terraform/internal/client"
+)
+
+// FakeClient is a function-field based fake of *client.Client's surface.
+// Tests set only the Func fields exercised by the code path under test;
+// calling a method whose Func field is nil fails loudly rather than
+// silently returning a zero value, so an unstubbed call surfaces as a test
+// failure instead of a confusing assertion mismatch.
+type FakeClient struct {
+	ListNetworksFunc func(ctx context.Context, host string) ([]client.Network, error)
+	ListImagesFunc   func(ctx context.Context, osFamily client.OSFamily) ([]client.Image, error)
+	CapabilitiesFunc func(ctx context.Context) (client.Capabilities, error)
+
+	CreateVMFunc              func(ctx context.Context, spec *client.VmSpec) (*client.JobResult, error)
+	DeleteVMFunc              func(ctx context.Context, vmID string) (*client.JobResult, error)
+	UpdateVMFunc              func(ctx context.Context, vmID string, spec *client.VMUpdateSpec) (*client.JobResult, error)
+	CreateDiskFunc            func(ctx context.Context, spec *client.DiskSpec) (*client.JobResult, error)
+	ResizeDiskFunc            func(ctx context.Context, diskID string, newSizeGB int) (*client.JobResult, error)
+	CreateNICFunc             func(ctx context.Context, spec *client.NicSpec) (*client.JobResult, error)
+	CreateCDROMFunc           func(ctx context.Context, spec *client.CDROMSpec) (*client.JobResult, error)
+	CreateCloudInitISOFunc    func(ctx context.Context, spec *client.CloudInitSpec) (*client.JobResult, error)
+	GetCloudInitISOFunc       func(ctx context.Context, isoID string) (*client.CloudInitISO, error)
+	InitializeVMFunc          func(ctx context.Context, vmID string, req *client.VMInitializationRequest) (*client.JobResult, error)
+	ListCheckpointsFunc       func(ctx context.Context, vmID string) ([]client.Checkpoint, error)
+	DeleteCheckpointFunc      func(ctx context.Context, checkpointID string) (*client.JobResult, error)
+	ListResourceTagsFunc      func(ctx context.Context, resourceType, resourceID string) (map[string]string, error)
+	UpdateResourceTagsFunc    func(ctx context.Context, resourceType, resourceID string, set map[string]string, unset []string) (map[string]string, error)
+	GetJobFunc                func(ctx context.Context, jobID string) (*client.Job, error)
+	WaitForJobWithOptionsFunc func(ctx context.Context, jobID string, opts client.WaitForJobOptions) (*client.Job, error)
+}
+
+// ListNetworks implements networksClient (see
+// internal/provider/networks_data_source.go).
+func (f *FakeClient) ListNetworks(ctx context.Context, host string) ([]client.Network, error) {
+	if f.ListNetworksFunc == nil {
+		return nil, fmt.Errorf("testclient: ListNetworksFunc not set")
+	}
+	return f.ListNetworksFunc(ctx, host)
+}
+
+// ListImages implements imagesClient (see
+// internal/provider/images_data_source.go).
+func (f *FakeClient) ListImages(ctx context.Context, osFamily client.OSFamily) ([]client.Image, error) {
+	if f.ListImagesFunc == nil {
+		return nil, fmt.Errorf("testclient: ListImagesFunc not set")
+	}
+	return f.ListImagesFunc(ctx, osFamily)
+}
+
+// Capabilities implements imagesClient (see
+// internal/provider/images_data_source.go).
+func (f *FakeClient) Capabilities(ctx context.Context) (client.Capabilities, error) {
+	if f.CapabilitiesFunc == nil {
+		return client.Capabilities{}, fmt.Errorf("testclient: CapabilitiesFunc not set")
+	}
+	return f.CapabilitiesFunc(ctx)
+}
+
+// CreateVM implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) CreateVM(ctx context.Context, spec *client.VmSpec) (*client.JobResult, error) {
+	if f.CreateVMFunc == nil {
+		return nil, fmt.Errorf("testclient: CreateVMFunc not set")
+	}
+	return f.CreateVMFunc(ctx, spec)
+}
+
+// DeleteVM implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) DeleteVM(ctx context.Context, vmID string) (*client.JobResult, error) {
+	if f.DeleteVMFunc == nil {
+		return nil, fmt.Errorf("testclient: DeleteVMFunc not set")
+	}
+	return f.DeleteVMFunc(ctx, vmID)
+}
+
+// UpdateVM implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) UpdateVM(ctx context.Context, vmID string, spec *client.VMUpdateSpec) (*client.JobResult, error) {
+	if f.UpdateVMFunc == nil {
+		return nil, fmt.Errorf("testclient: UpdateVMFunc not set")
+	}
+	return f.UpdateVMFunc(ctx, vmID, spec)
+}
+
+// CreateDisk implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) CreateDisk(ctx context.Context, spec *client.DiskSpec) (*client.JobResult, error) {
+	if f.CreateDiskFunc == nil {
+		return nil, fmt.Errorf("testclient: CreateDiskFunc not set")
+	}
+	return f.CreateDiskFunc(ctx, spec)
+}
+
+// ResizeDisk implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) ResizeDisk(ctx context.Context, diskID string, newSizeGB int) (*client.JobResult, error) {
+	if f.ResizeDiskFunc == nil {
+		return nil, fmt.Errorf("testclient: ResizeDiskFunc not set")
+	}
+	return f.ResizeDiskFunc(ctx, diskID, newSizeGB)
+}
+
+// CreateNIC implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) CreateNIC(ctx context.Context, spec *client.NicSpec) (*client.JobResult, error) {
+	if f.CreateNICFunc == nil {
+		return nil, fmt.Errorf("testclient: CreateNICFunc not set")
+	}
+	return f.CreateNICFunc(ctx, spec)
+}
+
+// CreateCDROM implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) CreateCDROM(ctx context.Context, spec *client.CDROMSpec) (*client.JobResult, error) {
+	if f.CreateCDROMFunc == nil {
+		return nil, fmt.Errorf("testclient: CreateCDROMFunc not set")
+	}
+	return f.CreateCDROMFunc(ctx, spec)
+}
+
+// CreateCloudInitISO implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) CreateCloudInitISO(ctx context.Context, spec *client.CloudInitSpec) (*client.JobResult, error) {
+	if f.CreateCloudInitISOFunc == nil {
+		return nil, fmt.Errorf("testclient: CreateCloudInitISOFunc not set")
+	}
+	return f.CreateCloudInitISOFunc(ctx, spec)
+}
+
+// GetCloudInitISO implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) GetCloudInitISO(ctx context.Context, isoID string) (*client.CloudInitISO, error) {
+	if f.GetCloudInitISOFunc == nil {
+		return nil, fmt.Errorf("testclient: GetCloudInitISOFunc not set")
+	}
+	return f.GetCloudInitISOFunc(ctx, isoID)
+}
+
+// InitializeVM implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) InitializeVM(ctx context.Context, vmID string, req *client.VMInitializationRequest) (*client.JobResult, error) {
+	if f.InitializeVMFunc == nil {
+		return nil, fmt.Errorf("testclient: InitializeVMFunc not set")
+	}
+	return f.InitializeVMFunc(ctx, vmID, req)
+}
+
+// ListCheckpoints implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) ListCheckpoints(ctx context.Context, vmID string) ([]client.Checkpoint, error) {
+	if f.ListCheckpointsFunc == nil {
+		return nil, fmt.Errorf("testclient: ListCheckpointsFunc not set")
+	}
+	return f.ListCheckpointsFunc(ctx, vmID)
+}
+
+// DeleteCheckpoint implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) DeleteCheckpoint(ctx context.Context, checkpointID string) (*client.JobResult, error) {
+	if f.DeleteCheckpointFunc == nil {
+		return nil, fmt.Errorf("testclient: DeleteCheckpointFunc not set")
+	}
+	return f.DeleteCheckpointFunc(ctx, checkpointID)
+}
+
+// ListResourceTags implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) ListResourceTags(ctx context.Context, resourceType, resourceID string) (map[string]string, error) {
+	if f.ListResourceTagsFunc == nil {
+		return nil, fmt.Errorf("testclient: ListResourceTagsFunc not set")
+	}
+	return f.ListResourceTagsFunc(ctx, resourceType, resourceID)
+}
+
+// UpdateResourceTags implements vmClient and tagging.Client (see
+// internal/provider/vm_resource.go and internal/provider/tagging).
+func (f *FakeClient) UpdateResourceTags(ctx context.Context, resourceType, resourceID string, set map[string]string, unset []string) (map[string]string, error) {
+	if f.UpdateResourceTagsFunc == nil {
+		return nil, fmt.Errorf("testclient: UpdateResourceTagsFunc not set")
+	}
+	return f.UpdateResourceTagsFunc(ctx, resourceType, resourceID, set, unset)
+}
+
+// GetJob implements vmClient (see internal/provider/vm_resource.go).
+func (f *FakeClient) GetJob(ctx context.Context, jobID string) (*client.Job, error) {
+	if f.GetJobFunc == nil {
+		return nil, fmt.Errorf("testclient: GetJobFunc not set")
+	}
+	return f.GetJobFunc(ctx, jobID)
+}
+
+// WaitForJobWithOptions implements vmClient (see
+// internal/provider/vm_resource.go).
+func (f *FakeClient) WaitForJobWithOptions(ctx context.Context, jobID string, opts client.WaitForJobOptions) (*client.Job, error) {
+	if f.WaitForJobWithOptionsFunc == nil {
+		return nil, fmt.Errorf("testclient: WaitForJobWithOptionsFunc not set")
+	}
+	return f.WaitForJobWithOptionsFunc(ctx, jobID, opts)
+}
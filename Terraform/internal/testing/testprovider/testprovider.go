@@ -0,0 +1,32 @@
+// Copyright (c) Aether-V
+// SPDX-License-Identifier: MPL-2.0
+
+// Package testprovider wires the Aether-V provider up behind a protocol 6
+// server for use with terraform-plugin-testing acceptance tests. For
+// unit-testing a single data source or resource's logic without a live
+// backend, prefer constructing it directly against an
+// internal/testing/testclient.FakeClient instead of going through a full
+// provider server.
+package testprovider
+
+import (
+	"context"
+
+	"github.com/aetherv/aether-v/terraform/internal/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ProtocolV6ProviderFactories returns the map terraform-plugin-testing's
+// resource.TestCase.ProtoV6ProviderFactories expects, serving the muxed
+// Aether-V provider (framework + SDKv2 resources) under "aetherv".
+func ProtocolV6ProviderFactories(version string) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"aetherv": func() (tfprotov6.ProviderServer, error) {
+			muxServer, err := provider.NewMuxedServer(context.Background(), version)
+			if err != nil {
+				return nil, err
+			}
+			return muxServer(), nil
+		},
+	}
+}